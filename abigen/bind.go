@@ -0,0 +1,337 @@
+// Package abigen generates Go bindings of Ethereum contracts against web3go.Client, calls and transactions
+// use web3go types such as types.BlockNumberOrHash and types.TransactionArgs, events are filtered and
+// watched as types.Log, and reverts are decoded into typed custom errors.
+package abigen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/pkg/errors"
+)
+
+// reserved are identifiers used by generated code which can not be used as parameter names.
+var reserved = map[string]bool{
+	"opts": true, "sink": true, "client": true, "out": true, "outstruct": true, "err": true,
+	"parsed": true, "address": true, "txHash": true, "contract": true, "calldata": true,
+	"errors": true, "fmt": true, "big": true, "strings": true, "abi": true, "common": true,
+	"web3go": true, "bind": true, "types": true,
+}
+
+// Bind generates Go bindings of contracts, types, abis and bytecodes are the contract type names, JSON abis and
+// optional hex encoded bytecodes in the same order. Aliases rename functions, events and errors of abi.
+func Bind(types []string, abis []string, bytecodes []string, pkg string, aliases map[string]string) (string, error) {
+	if len(types) != len(abis) {
+		return "", errors.New("the number of types and abis mismatch")
+	}
+
+	structs := make(map[string]*tmplStruct)
+	data := &tmplData{Package: pkg}
+
+	for i, typ := range types {
+		var bytecode string
+		if i < len(bytecodes) {
+			bytecode = bytecodes[i]
+		}
+
+		contract, err := bindContract(typ, abis[i], bytecode, aliases, structs)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to bind contract %v", typ)
+		}
+		data.Contracts = append(data.Contracts, contract)
+	}
+
+	for _, s := range structs {
+		data.Structs = append(data.Structs, s)
+	}
+	sort.Slice(data.Structs, func(i, j int) bool { return data.Structs[i].Name < data.Structs[j].Name })
+
+	buffer := new(bytes.Buffer)
+	tmpl := template.Must(template.New("").Parse(tmplSource))
+	if err := tmpl.Execute(buffer, data); err != nil {
+		return "", errors.Wrap(err, "failed to execute template")
+	}
+
+	code, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("%v\n%s", err, buffer)
+	}
+	return string(code), nil
+}
+
+func bindContract(typ string, abiJson string, bytecode string, aliases map[string]string, structs map[string]*tmplStruct) (*tmplContract, error) {
+	evmABI, err := abi.JSON(strings.NewReader(abiJson))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse abi")
+	}
+
+	bytecode = strings.TrimPrefix(strings.TrimSpace(bytecode), "0x")
+	if strings.Contains(bytecode, "__$") {
+		return nil, errors.New("bytecode with unlinked libraries is not supported")
+	}
+
+	// compact the abi to embed it as string literal
+	compacted := new(bytes.Buffer)
+	if err := json.Compact(compacted, []byte(abiJson)); err != nil {
+		return nil, errors.Wrap(err, "failed to compact abi")
+	}
+
+	contract := &tmplContract{
+		Type:     abi.ToCamelCase(typ),
+		InputABI: compacted.String(),
+		InputBin: bytecode,
+		Fallback: evmABI.HasFallback(),
+		Receive:  evmABI.HasReceive(),
+	}
+
+	// identifiers are used to detect collisions of generated methods of contract
+	identifiers := map[string]bool{"Address": true, "DecodeError": true}
+	if contract.Fallback {
+		identifiers["Fallback"] = true
+	}
+	if contract.Receive {
+		identifiers["Receive"] = true
+	}
+	declare := func(original string, names ...string) error {
+		for _, name := range names {
+			if identifiers[name] {
+				return errors.Errorf("duplicated identifier %q (normalized %q), use alias for renaming", original, name)
+			}
+			identifiers[name] = true
+		}
+		return nil
+	}
+
+	contract.Constructor = bindParams(evmABI.Constructor.Inputs, structs)
+
+	for _, name := range sortedKeys(evmABI.Methods) {
+		original := evmABI.Methods[name]
+		method := &tmplMethod{
+			Original:   original.Name,
+			Name:       normalizeName(alias(aliases, original.Name), "M"),
+			ID:         fmt.Sprintf("0x%x", original.ID),
+			Sig:        original.String(),
+			Inputs:     bindParams(original.Inputs, structs),
+			Structured: structured(original.Outputs),
+		}
+		if err := declare(original.Name, method.Name); err != nil {
+			return nil, err
+		}
+
+		for j, output := range original.Outputs {
+			name := fmt.Sprintf("out%d", j)
+			if method.Structured {
+				name = abi.ToCamelCase(output.Name)
+			}
+			method.Outputs = append(method.Outputs, &tmplArg{Name: name, Type: bindType(output.Type, structs)})
+		}
+
+		if original.IsConstant() {
+			contract.Calls = append(contract.Calls, method)
+		} else {
+			contract.Transacts = append(contract.Transacts, method)
+		}
+	}
+
+	for _, name := range sortedKeys(evmABI.Events) {
+		original := evmABI.Events[name]
+		// anonymous events can not be filtered by signature
+		if original.Anonymous {
+			continue
+		}
+
+		event := &tmplEvent{
+			Original: original.Name,
+			Name:     normalizeName(alias(aliases, original.Name), "E"),
+			ID:       original.ID.Hex(),
+			Sig:      original.String(),
+		}
+		if err := declare(original.Name, "Filter"+event.Name, "Watch"+event.Name, "Parse"+event.Name); err != nil {
+			return nil, err
+		}
+
+		used := map[string]bool{"Raw": true}
+		params := bindParams(original.Inputs, structs)
+		for j, input := range original.Inputs {
+			field := &tmplArg{Name: fieldName(input.Name, j, used), Type: bindType(input.Type, structs)}
+			if input.Indexed {
+				field.Type = bindTopicType(input.Type, structs)
+				event.Indexed = append(event.Indexed, &tmplArg{Name: params[j].Name, Type: field.Type})
+			}
+			event.Fields = append(event.Fields, field)
+		}
+		contract.Events = append(contract.Events, event)
+	}
+
+	for _, name := range sortedKeys(evmABI.Errors) {
+		original := evmABI.Errors[name]
+		e := &tmplError{
+			Original: original.Name,
+			Name:     normalizeName(alias(aliases, original.Name), "E"),
+			Sig:      original.String(),
+		}
+
+		used := make(map[string]bool)
+		for j, input := range original.Inputs {
+			e.Fields = append(e.Fields, &tmplArg{Name: fieldName(input.Name, j, used), Type: bindType(input.Type, structs)})
+		}
+		contract.HasErrorArgs = contract.HasErrorArgs || len(e.Fields) > 0
+		contract.Errors = append(contract.Errors, e)
+	}
+
+	return contract, nil
+}
+
+// bindParams binds abi arguments to Go function parameters.
+func bindParams(args abi.Arguments, structs map[string]*tmplStruct) []*tmplArg {
+	params := make([]*tmplArg, len(args))
+	for i, arg := range args {
+		name := arg.Name
+		if name == "" || isKeyWord(name) || reserved[name] {
+			name = fmt.Sprintf("arg%d", i)
+		}
+		params[i] = &tmplArg{Name: name, Type: bindType(arg.Type, structs)}
+	}
+	return params
+}
+
+// bindType converts solidity types to Go ones, the types that cannot be exactly mapped (e.g. uint17)
+// use an upscaled type (e.g. *big.Int). Tuples are recorded in structs.
+func bindType(kind abi.Type, structs map[string]*tmplStruct) string {
+	switch kind.T {
+	case abi.TupleTy:
+		return bindStructType(kind, structs)
+	case abi.ArrayTy:
+		return fmt.Sprintf("[%d]", kind.Size) + bindType(*kind.Elem, structs)
+	case abi.SliceTy:
+		return "[]" + bindType(*kind.Elem, structs)
+	case abi.AddressTy:
+		return "common.Address"
+	case abi.IntTy, abi.UintTy:
+		prefix := ""
+		if kind.T == abi.UintTy {
+			prefix = "u"
+		}
+		switch kind.Size {
+		case 8, 16, 32, 64:
+			return fmt.Sprintf("%sint%d", prefix, kind.Size)
+		}
+		return "*big.Int"
+	case abi.FixedBytesTy:
+		return fmt.Sprintf("[%d]byte", kind.Size)
+	case abi.BytesTy:
+		return "[]byte"
+	case abi.HashTy:
+		return "common.Hash"
+	case abi.FunctionTy:
+		return "[24]byte"
+	default:
+		// string, bool types
+		return kind.String()
+	}
+}
+
+// bindTopicType converts the type of indexed event argument to Go, indexed arguments of reference
+// types are stored as the keccak256 hash of their encoding.
+func bindTopicType(kind abi.Type, structs map[string]*tmplStruct) string {
+	switch kind.T {
+	case abi.StringTy, abi.BytesTy, abi.SliceTy, abi.ArrayTy, abi.TupleTy:
+		return "common.Hash"
+	}
+	return bindType(kind, structs)
+}
+
+// bindStructType converts a solidity tuple type to a Go struct and records it in structs, nested tuples
+// are resolved recursively.
+func bindStructType(kind abi.Type, structs map[string]*tmplStruct) string {
+	// before solidity v0.5.11 the TupleRawName is empty, use the canonical expression to distinguish tuples
+	id := kind.TupleRawName + kind.String()
+	if s, ok := structs[id]; ok {
+		return s.Name
+	}
+
+	used := make(map[string]bool)
+	var fields []*tmplArg
+	for i, elem := range kind.TupleElems {
+		fields = append(fields, &tmplArg{
+			Name: fieldName(kind.TupleRawNames[i], i, used),
+			Type: bindType(*elem, structs),
+		})
+	}
+
+	name := abi.ToCamelCase(kind.TupleRawName)
+	if name == "" {
+		name = fmt.Sprintf("Struct%d", len(structs))
+	}
+	structs[id] = &tmplStruct{Name: name, Fields: fields}
+	return name
+}
+
+// fieldName returns the unique Go struct field name of an abi argument.
+func fieldName(name string, index int, used map[string]bool) string {
+	if name == "" {
+		name = fmt.Sprintf("arg%d", index)
+	}
+	field := abi.ResolveNameConflict(abi.ToCamelCase(name), func(s string) bool { return used[s] })
+	used[field] = true
+	return field
+}
+
+// normalizeName converts an abi name to an exported Go identifier, prefix is prepended if the name
+// starts with a digit.
+func normalizeName(name string, prefix string) string {
+	normalized := abi.ToCamelCase(name)
+	if len(normalized) > 0 && unicode.IsDigit(rune(normalized[0])) {
+		normalized = prefix + normalized
+	}
+	return normalized
+}
+
+// structured checks whether a list of abi arguments has enough information to be returned as a Go struct.
+func structured(args abi.Arguments) bool {
+	if len(args) < 2 {
+		return false
+	}
+	exists := make(map[string]bool)
+	for _, out := range args {
+		field := abi.ToCamelCase(out.Name)
+		if field == "" || exists[field] {
+			return false
+		}
+		exists[field] = true
+	}
+	return true
+}
+
+func alias(aliases map[string]string, name string) string {
+	if alias, ok := aliases[name]; ok {
+		return alias
+	}
+	return name
+}
+
+func isKeyWord(arg string) bool {
+	switch arg {
+	case "break", "case", "chan", "const", "continue", "default", "defer", "else", "fallthrough", "for",
+		"func", "go", "goto", "if", "import", "interface", "iota", "map", "make", "new", "package",
+		"range", "return", "select", "struct", "switch", "type", "var":
+		return true
+	}
+	return false
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
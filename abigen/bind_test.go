@@ -0,0 +1,53 @@
+package abigen
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestBindToken checks the binding of testdata is the same as internal/tokentest, which is compiled and tested
+func TestBindToken(t *testing.T) {
+	a := assert.New(t)
+
+	abiJson, err := os.ReadFile("testdata/token.abi")
+	a.NoError(err)
+	bytecode, err := os.ReadFile("testdata/token.bin")
+	a.NoError(err)
+	expect, err := os.ReadFile("internal/tokentest/token.go")
+	a.NoError(err)
+
+	code, err := Bind([]string{"Token"}, []string{string(abiJson)}, []string{string(bytecode)}, "tokentest", nil)
+	a.NoError(err)
+	a.Equal(string(expect), code, "binding is outdated, run go generate ./abigen/...")
+}
+
+func TestBindErrors(t *testing.T) {
+	abiJson := `[
+		{"type":"function","name":"foo","inputs":[],"outputs":[]},
+		{"type":"function","name":"Foo","inputs":[],"outputs":[]}
+	]`
+
+	_, err := Bind([]string{"T"}, []string{abiJson}, nil, "p", nil)
+	assert.Error(t, err)
+
+	_, err = Bind([]string{"T"}, []string{abiJson}, nil, "p", map[string]string{"Foo": "Bar"})
+	assert.NoError(t, err)
+
+	_, err = Bind([]string{"T"}, []string{`[{"type":"function","name":"address","inputs":[],"outputs":[]}]`}, nil, "p", nil)
+	assert.Error(t, err)
+
+	_, err = Bind([]string{"T"}, []string{`[]`}, []string{"0x60__$1234$__"}, "p", nil)
+	assert.Error(t, err)
+}
+
+func TestBindParams(t *testing.T) {
+	abiJson := `[{"type":"function","name":"f","stateMutability":"view",
+		"inputs":[{"name":"","type":"uint24"},{"name":"type","type":"int64[2]"},{"name":"opts","type":"bytes32[]"},{"name":"ok","type":"function"}],
+		"outputs":[]}]`
+
+	code, err := Bind([]string{"T"}, []string{abiJson}, nil, "p", nil)
+	assert.NoError(t, err)
+	assert.Contains(t, code, "func (_T *T) F(opts *bind.CallOpts, arg0 *big.Int, arg1 [2]int64, arg2 [][32]byte, ok [24]byte) error {")
+}
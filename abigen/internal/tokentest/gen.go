@@ -0,0 +1,4 @@
+// Package tokentest contains the binding generated from testdata/token.abi to test the generated code.
+package tokentest
+
+//go:generate go run ../../../cmd/abigen --abi ../../testdata/token.abi --bin ../../testdata/token.bin --pkg tokentest --type Token --out token.go
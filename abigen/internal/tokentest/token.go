@@ -0,0 +1,314 @@
+// Code generated by web3go abigen - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package tokentest
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/openweb3/web3go"
+	"github.com/openweb3/web3go/bind"
+	"github.com/openweb3/web3go/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = fmt.Sprintf
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = abi.ConvertType
+	_ = common.Big1
+	_ = web3go.NewClient
+	_ = bind.RevertData
+	_ = types.BlockNumberOrHash{}
+)
+
+// TokenInfo is an auto generated low-level Go binding around an user-defined struct.
+type TokenInfo struct {
+	Name     string
+	Decimals uint8
+	Holders  []common.Address
+}
+
+// TokenMetaData contains all meta data concerning the Token contract.
+var TokenMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"name\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"supply\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"balanceOf\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"info\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"struct Token.Info\",\"components\":[{\"name\":\"name\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"decimals\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"holders\",\"type\":\"address[]\",\"internalType\":\"address[]\"}]}]},{\"type\":\"function\",\"name\":\"reserves\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"reserve0\",\"type\":\"uint112\",\"internalType\":\"uint112\"},{\"name\":\"reserve1\",\"type\":\"uint112\",\"internalType\":\"uint112\"}]},{\"type\":\"function\",\"name\":\"pair\",\"stateMutability\":\"pure\",\"inputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}]},{\"type\":\"function\",\"name\":\"transfer\",\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}]},{\"type\":\"function\",\"name\":\"mint\",\"stateMutability\":\"nonpayable\",\"inputs\":[{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[]},{\"type\":\"function\",\"name\":\"mint\",\"stateMutability\":\"payable\",\"inputs\":[{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[]},{\"type\":\"event\",\"name\":\"Transfer\",\"anonymous\":false,\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}]},{\"type\":\"event\",\"name\":\"Memo\",\"anonymous\":false,\"inputs\":[{\"name\":\"tag\",\"type\":\"string\",\"indexed\":true,\"internalType\":\"string\"},{\"name\":\"data\",\"type\":\"bytes\",\"indexed\":false,\"internalType\":\"bytes\"}]},{\"type\":\"error\",\"name\":\"InsufficientBalance\",\"inputs\":[{\"name\":\"available\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"required\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"Unauthorized\",\"inputs\":[]},{\"type\":\"fallback\",\"stateMutability\":\"nonpayable\"},{\"type\":\"receive\",\"stateMutability\":\"payable\"}]",
+	Bin: "0x6080604052",
+}
+
+// Token is an auto generated Go binding around an Ethereum contract.
+type Token struct {
+	contract *bind.BoundContract
+}
+
+// NewToken creates a new instance of Token, bound to a specific deployed contract.
+func NewToken(address common.Address, client *web3go.Client) (*Token, error) {
+	parsed, err := TokenMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return &Token{contract: bind.NewBoundContract(address, parsed, client)}, nil
+}
+
+// DeployToken deploys a new Ethereum contract, binding an instance of Token to it.
+func DeployToken(opts types.TransactionArgs, client *web3go.Client, name string, supply *big.Int) (common.Address, common.Hash, *Token, error) {
+	parsed, err := TokenMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, common.Hash{}, nil, err
+	}
+
+	address, txHash, contract, err := bind.DeployContract(opts, parsed, common.FromHex(TokenMetaData.Bin), client, name, supply)
+	if err != nil {
+		return common.Address{}, common.Hash{}, nil, err
+	}
+	return address, txHash, &Token{contract: contract}, nil
+}
+
+// Address returns the address of the bound contract.
+func (_Token *Token) Address() common.Address {
+	return _Token.contract.Address()
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_Token *Token) BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	out, err := _Token.contract.Call(opts, "balanceOf", owner)
+	if err != nil {
+		return *new(*big.Int), _Token.DecodeError(err)
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	return out0, nil
+}
+
+// Info is a free data retrieval call binding the contract method 0x370158ea.
+//
+// Solidity: function info() view returns((string,uint8,address[]))
+func (_Token *Token) Info(opts *bind.CallOpts) (TokenInfo, error) {
+	out, err := _Token.contract.Call(opts, "info")
+	if err != nil {
+		return *new(TokenInfo), _Token.DecodeError(err)
+	}
+
+	out0 := *abi.ConvertType(out[0], new(TokenInfo)).(*TokenInfo)
+	return out0, nil
+}
+
+// Pair is a free data retrieval call binding the contract method 0x41679fd2.
+//
+// Solidity: function pair(bytes32 ) pure returns(address, bool)
+func (_Token *Token) Pair(opts *bind.CallOpts, arg0 [32]byte) (common.Address, bool, error) {
+	out, err := _Token.contract.Call(opts, "pair", arg0)
+	if err != nil {
+		return *new(common.Address), *new(bool), _Token.DecodeError(err)
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	out1 := *abi.ConvertType(out[1], new(bool)).(*bool)
+	return out0, out1, nil
+}
+
+// Reserves is a free data retrieval call binding the contract method 0x75172a8b.
+//
+// Solidity: function reserves() view returns(uint112 reserve0, uint112 reserve1)
+func (_Token *Token) Reserves(opts *bind.CallOpts) (struct {
+	Reserve0 *big.Int
+	Reserve1 *big.Int
+}, error) {
+	out, err := _Token.contract.Call(opts, "reserves")
+	outstruct := new(struct {
+		Reserve0 *big.Int
+		Reserve1 *big.Int
+	})
+	if err != nil {
+		return *outstruct, _Token.DecodeError(err)
+	}
+
+	outstruct.Reserve0 = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Reserve1 = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	return *outstruct, nil
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x6a627842.
+//
+// Solidity: function mint(address to) returns()
+func (_Token *Token) Mint(opts types.TransactionArgs, to common.Address) (common.Hash, error) {
+	txHash, err := _Token.contract.Transact(opts, "mint", to)
+	return txHash, _Token.DecodeError(err)
+}
+
+// Mint0 is a paid mutator transaction binding the contract method 0x40c10f19.
+//
+// Solidity: function mint(address to, uint256 amount) payable returns()
+func (_Token *Token) Mint0(opts types.TransactionArgs, to common.Address, amount *big.Int) (common.Hash, error) {
+	txHash, err := _Token.contract.Transact(opts, "mint0", to, amount)
+	return txHash, _Token.DecodeError(err)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 amount) returns(bool)
+func (_Token *Token) Transfer(opts types.TransactionArgs, to common.Address, amount *big.Int) (common.Hash, error) {
+	txHash, err := _Token.contract.Transact(opts, "transfer", to, amount)
+	return txHash, _Token.DecodeError(err)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+func (_Token *Token) Fallback(opts types.TransactionArgs, calldata []byte) (common.Hash, error) {
+	txHash, err := _Token.contract.RawTransact(opts, calldata)
+	return txHash, _Token.DecodeError(err)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+func (_Token *Token) Receive(opts types.TransactionArgs) (common.Hash, error) {
+	txHash, err := _Token.contract.Transfer(opts)
+	return txHash, _Token.DecodeError(err)
+}
+
+// TokenMemo represents a Memo event raised by the Token contract.
+type TokenMemo struct {
+	Tag  common.Hash
+	Data []byte
+	Raw  types.Log // Blockchain specific contextual infos
+}
+
+// FilterMemo is a free log retrieval operation binding the contract event 0x758c01a94113480ab87689a7ce9e1b3d410389446d41725de44faddc29b7fcf3.
+//
+// Solidity: event Memo(string indexed tag, bytes data)
+func (_Token *Token) FilterMemo(opts *bind.FilterOpts, tag []common.Hash) ([]*TokenMemo, error) {
+	var tagRule []interface{}
+	for _, tagItem := range tag {
+		tagRule = append(tagRule, tagItem)
+	}
+	return bind.FilterEvents(_Token.contract, opts, "Memo", _Token.ParseMemo, tagRule)
+}
+
+// WatchMemo is a free log subscription operation binding the contract event 0x758c01a94113480ab87689a7ce9e1b3d410389446d41725de44faddc29b7fcf3.
+//
+// Solidity: event Memo(string indexed tag, bytes data)
+func (_Token *Token) WatchMemo(opts *bind.WatchOpts, sink chan<- *TokenMemo, tag []common.Hash) (types.Subscription, error) {
+	var tagRule []interface{}
+	for _, tagItem := range tag {
+		tagRule = append(tagRule, tagItem)
+	}
+	return bind.WatchEvents(_Token.contract, opts, "Memo", sink, _Token.ParseMemo, tagRule)
+}
+
+// ParseMemo is a log parse operation binding the contract event 0x758c01a94113480ab87689a7ce9e1b3d410389446d41725de44faddc29b7fcf3.
+//
+// Solidity: event Memo(string indexed tag, bytes data)
+func (_Token *Token) ParseMemo(log types.Log) (*TokenMemo, error) {
+	event := new(TokenMemo)
+	if err := _Token.contract.UnpackLog(event, "Memo", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// TokenTransfer represents a Transfer event raised by the Token contract.
+type TokenTransfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_Token *Token) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) ([]*TokenTransfer, error) {
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	return bind.FilterEvents(_Token.contract, opts, "Transfer", _Token.ParseTransfer, fromRule, toRule)
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_Token *Token) WatchTransfer(opts *bind.WatchOpts, sink chan<- *TokenTransfer, from []common.Address, to []common.Address) (types.Subscription, error) {
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	return bind.WatchEvents(_Token.contract, opts, "Transfer", sink, _Token.ParseTransfer, fromRule, toRule)
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_Token *Token) ParseTransfer(log types.Log) (*TokenTransfer, error) {
+	event := new(TokenTransfer)
+	if err := _Token.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// TokenInsufficientBalanceError represents a InsufficientBalance error raised by the Token contract.
+//
+// Solidity: error InsufficientBalance(uint256 available, uint256 required)
+type TokenInsufficientBalanceError struct {
+	Available *big.Int
+	Required  *big.Int
+}
+
+// Error implements the error interface.
+func (e *TokenInsufficientBalanceError) Error() string {
+	return fmt.Sprintf("InsufficientBalance%+v", *e)
+}
+
+// TokenUnauthorizedError represents a Unauthorized error raised by the Token contract.
+//
+// Solidity: error Unauthorized()
+type TokenUnauthorizedError struct {
+}
+
+// Error implements the error interface.
+func (e *TokenUnauthorizedError) Error() string {
+	return fmt.Sprintf("Unauthorized%+v", *e)
+}
+
+// DecodeError converts the revert error of Token to the typed custom error of contract if the revert
+// data matches, otherwise err is returned as is.
+func (_Token *Token) DecodeError(err error) error {
+	data, ok := bind.RevertData(err)
+	if !ok {
+		return err
+	}
+
+	e, values, unpackErr := _Token.contract.UnpackError(data)
+	if unpackErr != nil {
+		return err
+	}
+
+	switch e.Name {
+	case "InsufficientBalance":
+		decoded := new(TokenInsufficientBalanceError)
+		decoded.Available = *abi.ConvertType(values[0], new(*big.Int)).(**big.Int)
+		decoded.Required = *abi.ConvertType(values[1], new(*big.Int)).(**big.Int)
+		return decoded
+	case "Unauthorized":
+		decoded := new(TokenUnauthorizedError)
+		return decoded
+	}
+	return err
+}
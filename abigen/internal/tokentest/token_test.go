@@ -0,0 +1,250 @@
+package tokentest
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	rpc "github.com/openweb3/go-rpc-provider"
+	"github.com/openweb3/web3go"
+	"github.com/openweb3/web3go/bind"
+	"github.com/openweb3/web3go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	tokenAddr = common.HexToAddress("0x0000000000000000000000000000000000001001")
+	alice     = common.HexToAddress("0x000000000000000000000000000000000000a11c")
+	bob       = common.HexToAddress("0x0000000000000000000000000000000000000b0b")
+)
+
+// fakeEthAPI serves eth methods with canned results of the Token contract.
+type fakeEthAPI struct {
+	sent  []types.TransactionArgs
+	query types.FilterQuery
+	logs  []types.Log
+}
+
+func (api *fakeEthAPI) ChainId() hexutil.Uint64 { return 1 }
+
+func (api *fakeEthAPI) GasPrice() *hexutil.Big { return (*hexutil.Big)(big.NewInt(1)) }
+
+func (api *fakeEthAPI) MaxPriorityFeePerGas() *hexutil.Big { return (*hexutil.Big)(big.NewInt(1)) }
+
+func (api *fakeEthAPI) GetBlockByNumber(number types.BlockNumber, isFull bool) map[string]interface{} {
+	return map[string]interface{}{"number": "0x1", "difficulty": "0x0", "baseFeePerGas": "0x1", "transactions": []interface{}{}}
+}
+
+func (api *fakeEthAPI) GetTransactionCount(addr common.Address, block *types.BlockNumberOrHash) hexutil.Uint64 {
+	return 5
+}
+
+func (api *fakeEthAPI) GetCode(addr common.Address, block *types.BlockNumberOrHash) hexutil.Bytes {
+	if addr == tokenAddr {
+		return []byte{0x60}
+	}
+	return nil
+}
+
+func (api *fakeEthAPI) EstimateGas(req types.CallRequest, block *types.BlockNumberOrHash, overrides *types.StateOverride, blockOverrides *types.BlockOverrides) (hexutil.Uint64, error) {
+	return 21000, api.revert(req.Data)
+}
+
+func (api *fakeEthAPI) SendTransaction(args types.TransactionArgs) common.Hash {
+	api.sent = append(api.sent, args)
+	return common.Hash{0x01}
+}
+
+func (api *fakeEthAPI) Call(req types.CallRequest, block *types.BlockNumberOrHash, overrides *types.StateOverride, blockOverrides *types.BlockOverrides) (hexutil.Bytes, error) {
+	if err := api.revert(req.Data); err != nil {
+		return nil, err
+	}
+
+	parsed, _ := TokenMetaData.GetAbi()
+	method, err := parsed.MethodById(req.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	case "balanceOf":
+		return method.Outputs.Pack(big.NewInt(100))
+	case "info":
+		return method.Outputs.Pack(TokenInfo{Name: "token", Decimals: 18, Holders: []common.Address{alice, bob}})
+	case "reserves":
+		return method.Outputs.Pack(big.NewInt(1), big.NewInt(2))
+	}
+	return nil, errors.New("unexpected method")
+}
+
+// revert reverts transfer with custom errors
+func (api *fakeEthAPI) revert(data []byte) error {
+	parsed, _ := TokenMetaData.GetAbi()
+	method, err := parsed.MethodById(data)
+	if err != nil || method.Name != "transfer" {
+		return nil
+	}
+
+	args, _ := method.Inputs.Unpack(data[4:])
+	amount := args[1].(*big.Int)
+	var revertData []byte
+	switch {
+	case amount.Cmp(big.NewInt(100)) > 0:
+		e := parsed.Errors["InsufficientBalance"]
+		input, _ := e.Inputs.Pack(big.NewInt(100), amount)
+		revertData = append(e.ID[:4], input...)
+	case amount.Sign() == 0:
+		id := parsed.Errors["Unauthorized"].ID
+		revertData = id[:4]
+	default:
+		return nil
+	}
+	return &rpc.JsonError{Code: 3, Message: "execution reverted", Data: hexutil.Encode(revertData)}
+}
+
+func (api *fakeEthAPI) GetLogs(query types.FilterQuery) []types.Log {
+	api.query = query
+	return api.logs
+}
+
+func (api *fakeEthAPI) Logs(ctx context.Context, query types.FilterQuery) (*rpc.Subscription, error) {
+	notifier, _ := rpc.NotifierFromContext(ctx)
+	sub := notifier.CreateSubscription()
+	go func() {
+		for _, log := range api.logs {
+			notifier.Notify(sub.ID, log)
+		}
+	}()
+	return sub, nil
+}
+
+func newTestToken(t *testing.T) (*Token, *fakeEthAPI) {
+	c, api := newTestClient(t)
+	token, err := NewToken(tokenAddr, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token, api
+}
+
+func newTestClient(t *testing.T) (*web3go.Client, *fakeEthAPI) {
+	api := &fakeEthAPI{}
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", api); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(server.Stop)
+	return web3go.NewClientWithProvider(rpc.DialInProc(server)), api
+}
+
+func transferLog(from, to common.Address, value int64) types.Log {
+	data, _ := abi.Arguments{{Type: abi.Type{T: abi.UintTy, Size: 256}}}.Pack(big.NewInt(value))
+	parsed, _ := TokenMetaData.GetAbi()
+	return types.Log{
+		Address: tokenAddr,
+		Topics:  []common.Hash{parsed.Events["Transfer"].ID, common.BytesToHash(from[:]), common.BytesToHash(to[:])},
+		Data:    data,
+	}
+}
+
+func TestCalls(t *testing.T) {
+	a := assert.New(t)
+	token, _ := newTestToken(t)
+
+	balance, err := token.BalanceOf(nil, alice)
+	a.NoError(err)
+	a.Equal(int64(100), balance.Int64())
+
+	info, err := token.Info(&bind.CallOpts{Context: context.Background()})
+	a.NoError(err)
+	a.Equal(TokenInfo{Name: "token", Decimals: 18, Holders: []common.Address{alice, bob}}, info)
+
+	reserves, err := token.Reserves(nil)
+	a.NoError(err)
+	a.Equal(int64(1), reserves.Reserve0.Int64())
+	a.Equal(int64(2), reserves.Reserve1.Int64())
+}
+
+func TestTransactAndDeploy(t *testing.T) {
+	a := assert.New(t)
+	token, api := newTestToken(t)
+
+	txHash, err := token.Transfer(types.TransactionArgs{From: &alice}, bob, big.NewInt(10))
+	a.NoError(err)
+	a.Equal(common.Hash{0x01}, txHash)
+	a.Equal(tokenAddr, *api.sent[0].To)
+	a.Equal(uint64(5), uint64(*api.sent[0].Nonce))
+
+	c, api := newTestClient(t)
+	address, _, deployed, err := DeployToken(types.TransactionArgs{From: &alice}, c, "token", big.NewInt(1))
+	a.NoError(err)
+	a.Equal(crypto.CreateAddress(alice, 5), address)
+	a.Equal(address, deployed.Address())
+	a.Nil(api.sent[0].To)
+
+	parsed, _ := TokenMetaData.GetAbi()
+	input, _ := parsed.Pack("", "token", big.NewInt(1))
+	a.Equal(append(common.FromHex(TokenMetaData.Bin), input...), []byte(*api.sent[0].Data))
+}
+
+func TestDecodeError(t *testing.T) {
+	a := assert.New(t)
+	token, api := newTestToken(t)
+
+	_, err := token.Transfer(types.TransactionArgs{From: &alice}, bob, big.NewInt(1000))
+	var insufficient *TokenInsufficientBalanceError
+	a.True(errors.As(err, &insufficient))
+	a.Equal(int64(100), insufficient.Available.Int64())
+	a.Equal(int64(1000), insufficient.Required.Int64())
+
+	_, err = token.Transfer(types.TransactionArgs{From: &alice}, bob, big.NewInt(0))
+	a.IsType(&TokenUnauthorizedError{}, err)
+	a.Empty(api.sent)
+
+	plain := errors.New("plain")
+	a.Equal(plain, token.DecodeError(plain))
+	a.Nil(token.DecodeError(nil))
+}
+
+func TestFilterAndWatch(t *testing.T) {
+	a := assert.New(t)
+	token, api := newTestToken(t)
+	api.logs = []types.Log{transferLog(alice, bob, 7), transferLog(bob, alice, 8)}
+
+	events, err := token.FilterTransfer(nil, []common.Address{alice, bob}, nil)
+	a.NoError(err)
+	a.Len(events, 2)
+	a.Equal(alice, events[0].From)
+	a.Equal(bob, events[0].To)
+	a.Equal(int64(7), events[0].Value.Int64())
+	a.Equal(api.logs[1], events[1].Raw)
+
+	a.Equal([]common.Address{tokenAddr}, api.query.Addresses)
+	a.Equal([][]common.Hash{
+		{crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))},
+		{common.BytesToHash(alice[:]), common.BytesToHash(bob[:])},
+		nil,
+	}, api.query.Topics)
+
+	sink := make(chan *TokenTransfer)
+	sub, err := token.WatchTransfer(nil, sink, nil, []common.Address{alice})
+	a.NoError(err)
+	defer sub.Unsubscribe()
+
+	for i := 0; i < 2; i++ {
+		select {
+		case ev := <-sink:
+			a.Equal(api.logs[i].Data, ev.Raw.Data)
+		case err := <-sub.Err():
+			t.Fatal(err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout")
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package abigen
+
+import (
+	_ "embed"
+)
+
+// tmplData is the data structure required to fill the binding template.
+type tmplData struct {
+	Package   string          // Name of the package to place the generated file in
+	Contracts []*tmplContract // List of contracts to generate into this file
+	Structs   []*tmplStruct   // Contract struct type definitions
+}
+
+// tmplContract contains the data needed to generate an individual contract binding.
+type tmplContract struct {
+	Type         string        // Type name of the main contract binding
+	InputABI     string        // JSON ABI used as the input to generate the binding from
+	InputBin     string        // Optional EVM bytecode used to generate deploy code from
+	Constructor  []*tmplArg    // Arguments of contract constructor
+	Calls        []*tmplMethod // Contract calls that only read state data
+	Transacts    []*tmplMethod // Contract calls that write state data
+	Fallback     bool          // Whether the contract has a fallback function
+	Receive      bool          // Whether the contract has a receive function
+	Events       []*tmplEvent  // Contract events accessors
+	Errors       []*tmplError  // Contract custom errors
+	HasErrorArgs bool          // Whether any custom error has arguments
+}
+
+// tmplMethod contains the data needed to generate a contract method binding.
+type tmplMethod struct {
+	Original   string     // Name of the method in abi
+	Name       string     // Normalized Go name of the method
+	ID         string     // Hex encoded 4 bytes selector
+	Sig        string     // Solidity declaration of the method
+	Inputs     []*tmplArg // Method parameters
+	Outputs    []*tmplArg // Method returns, names are Go field names if Structured
+	Structured bool       // Whether the returns should be accumulated into a struct
+}
+
+// tmplEvent contains the data needed to generate a contract event binding.
+type tmplEvent struct {
+	Original string     // Name of the event in abi
+	Name     string     // Normalized Go name of the event
+	ID       string     // Hex encoded event signature topic
+	Sig      string     // Solidity declaration of the event
+	Fields   []*tmplArg // Fields of the event struct
+	Indexed  []*tmplArg // Indexed arguments used to filter events, types are topic types
+}
+
+// tmplError contains the data needed to generate a contract custom error binding.
+type tmplError struct {
+	Original string     // Name of the error in abi
+	Name     string     // Normalized Go name of the error
+	Sig      string     // Solidity declaration of the error
+	Fields   []*tmplArg // Fields of the error struct
+}
+
+// tmplArg is a Go parameter or struct field bound to an abi argument.
+type tmplArg struct {
+	Name string // Go parameter or field name
+	Type string // Go type
+}
+
+// tmplStruct is a Go struct bound to an abi tuple.
+type tmplStruct struct {
+	Name   string     // Struct name by the internal type of tuple or auto-generated
+	Fields []*tmplArg // Struct fields
+}
+
+// tmplSource is the Go source template that the generated Go contract binding is based on.
+//
+//go:embed source.go.tpl
+var tmplSource string
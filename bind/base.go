@@ -0,0 +1,297 @@
+// Package bind is the runtime of contract bindings generated by web3go abigen, it calls, transacts
+// and filters logs of contracts through web3go.Client.
+package bind
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/openweb3/web3go"
+	"github.com/openweb3/web3go/client"
+	"github.com/openweb3/web3go/types"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrNoCode                 = errors.New("no contract code at given address")
+	ErrNoEventSignature       = errors.New("no event signature")
+	ErrEventSignatureMismatch = errors.New("event signature mismatch")
+)
+
+// MetaData collects the abi and bytecode of a generated contract binding, the abi is parsed once on demand.
+type MetaData struct {
+	ABI string
+	Bin string
+
+	mutex sync.Mutex
+	abi   *abi.ABI
+}
+
+// GetAbi returns the parsed abi of contract.
+func (m *MetaData) GetAbi() (*abi.ABI, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.abi != nil {
+		return m.abi, nil
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(m.ABI))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse abi")
+	}
+	m.abi = &parsed
+	return m.abi, nil
+}
+
+// CallOpts is the collection of options to fine tune a contract call request.
+type CallOpts struct {
+	From        *common.Address          // Optional the sender address, otherwise the zero address is used
+	BlockNumber *types.BlockNumberOrHash // Optional the block to call on, nil means latest block
+	Context     context.Context          // Network context to support cancellation and timeouts (nil = client context)
+}
+
+// FilterOpts is the collection of options to fine tune filtering for events within a bound contract.
+type FilterOpts struct {
+	FromBlock *types.BlockNumber // Start of the queried range, nil means latest block
+	ToBlock   *types.BlockNumber // End of the range, nil means latest block
+	BlockHash *common.Hash       // Optional return logs only from block with this hash
+	Context   context.Context    // Network context to support cancellation and timeouts (nil = client context)
+}
+
+// WatchOpts is the collection of options to fine tune subscribing for events within a bound contract.
+type WatchOpts struct {
+	Context context.Context // Network context to support cancellation and timeouts (nil = client context)
+}
+
+// BoundContract is the base wrapper object that reflects a contract on the Ethereum network.
+type BoundContract struct {
+	address common.Address
+	abi     *abi.ABI
+	client  *web3go.Client
+}
+
+// NewBoundContract creates a low level contract interface through which calls and transactions may be made through.
+func NewBoundContract(address common.Address, abi *abi.ABI, client *web3go.Client) *BoundContract {
+	return &BoundContract{
+		address: address,
+		abi:     abi,
+		client:  client,
+	}
+}
+
+// DeployContract deploys a contract onto the Ethereum blockchain and binds the deployment address with a Go wrapper.
+// The contract address is computed by opts.From and the nonce populated by TransactionArgs.Populate.
+func DeployContract(opts types.TransactionArgs, abi *abi.ABI, bytecode []byte, client *web3go.Client, params ...interface{}) (common.Address, common.Hash, *BoundContract, error) {
+	input, err := abi.Pack("", params...)
+	if err != nil {
+		return common.Address{}, common.Hash{}, nil, errors.Wrap(err, "failed to pack constructor arguments")
+	}
+
+	data := hexutil.Bytes(append(common.CopyBytes(bytecode), input...))
+	opts.To = nil
+	opts.Data = &data
+
+	if err := opts.Populate(client.Eth); err != nil {
+		return common.Address{}, common.Hash{}, nil, errors.Wrap(err, "failed to populate transaction")
+	}
+
+	txHash, err := client.Eth.SendTransactionByArgs(opts)
+	if err != nil {
+		return common.Address{}, common.Hash{}, nil, err
+	}
+
+	address := crypto.CreateAddress(*opts.From, uint64(*opts.Nonce))
+	return address, txHash, NewBoundContract(address, abi, client), nil
+}
+
+// Address returns the address of contract.
+func (c *BoundContract) Address() common.Address {
+	return c.address
+}
+
+// Abi returns the abi of contract.
+func (c *BoundContract) Abi() *abi.ABI {
+	return c.abi
+}
+
+// Call invokes the (constant) contract method with params as input values and returns the unpacked output values.
+func (c *BoundContract) Call(opts *CallOpts, method string, params ...interface{}) ([]interface{}, error) {
+	if opts == nil {
+		opts = new(CallOpts)
+	}
+
+	input, err := c.abi.Pack(method, params...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to pack method %v", method)
+	}
+
+	eth := c.eth(opts.Context)
+	output, err := eth.Call(types.CallRequest{
+		From: opts.From,
+		To:   &c.address,
+		Data: input,
+	}, opts.BlockNumber, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 && len(c.abi.Methods[method].Outputs) > 0 {
+		// distinguish empty output of contract from no code
+		if code, err := eth.CodeAt(c.address, opts.BlockNumber); err != nil {
+			return nil, errors.Wrap(err, "failed to get code")
+		} else if len(code) == 0 {
+			return nil, ErrNoCode
+		}
+	}
+
+	return c.abi.Unpack(method, output)
+}
+
+// Transact invokes the (paid) contract method with params as input values, the transaction is sent by
+// RpcEthClient.SendTransactionByArgs, so unset fields of opts are populated and the transaction is signed
+// by the signer manager of client if it is enabled.
+func (c *BoundContract) Transact(opts types.TransactionArgs, method string, params ...interface{}) (common.Hash, error) {
+	input, err := c.abi.Pack(method, params...)
+	if err != nil {
+		return common.Hash{}, errors.Wrapf(err, "failed to pack method %v", method)
+	}
+	return c.RawTransact(opts, input)
+}
+
+// RawTransact initiates a transaction with the given raw calldata as the input.
+// It's usually used to initiate transactions for invoking **Fallback** function.
+func (c *BoundContract) RawTransact(opts types.TransactionArgs, calldata []byte) (common.Hash, error) {
+	data := hexutil.Bytes(calldata)
+	opts.To = &c.address
+	opts.Data = &data
+	return c.client.Eth.SendTransactionByArgs(opts)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling its default method if one is available.
+func (c *BoundContract) Transfer(opts types.TransactionArgs) (common.Hash, error) {
+	return c.RawTransact(opts, nil)
+}
+
+// FilterLogs returns the logs of event matching the indexed arguments query.
+func (c *BoundContract) FilterLogs(opts *FilterOpts, name string, query ...[]interface{}) ([]types.Log, error) {
+	if opts == nil {
+		opts = new(FilterOpts)
+	}
+
+	topics, err := c.topics(name, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.eth(opts.Context).Logs(types.FilterQuery{
+		BlockHash: opts.BlockHash,
+		FromBlock: opts.FromBlock,
+		ToBlock:   opts.ToBlock,
+		Addresses: []common.Address{c.address},
+		Topics:    topics,
+	})
+}
+
+// WatchLogs subscribes to the logs of event matching the indexed arguments query, it requires a provider
+// supporting subscription such as websocket.
+func (c *BoundContract) WatchLogs(opts *WatchOpts, name string, sink chan<- types.Log, query ...[]interface{}) (types.Subscription, error) {
+	if opts == nil {
+		opts = new(WatchOpts)
+	}
+
+	topics, err := c.topics(name, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.eth(opts.Context).SubscribeFilterLogs(types.FilterQuery{
+		Addresses: []common.Address{c.address},
+		Topics:    topics,
+	}, sink)
+}
+
+// UnpackLog unpacks a retrieved log into the provided output structure.
+func (c *BoundContract) UnpackLog(out interface{}, name string, log types.Log) error {
+	event, ok := c.abi.Events[name]
+	if !ok {
+		return errors.Errorf("event %v not found", name)
+	}
+
+	topics := log.Topics
+	if !event.Anonymous {
+		if len(topics) == 0 {
+			return ErrNoEventSignature
+		}
+		if topics[0] != event.ID {
+			return ErrEventSignatureMismatch
+		}
+		topics = topics[1:]
+	}
+
+	if len(log.Data) > 0 {
+		if err := c.abi.UnpackIntoInterface(out, name, log.Data); err != nil {
+			return errors.Wrap(err, "failed to unpack log data")
+		}
+	}
+
+	var indexed abi.Arguments
+	for _, arg := range event.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	return abi.ParseTopics(out, indexed, topics)
+}
+
+// UnpackError finds the custom error of contract by the selector of revert data and unpacks its arguments.
+func (c *BoundContract) UnpackError(data []byte) (*abi.Error, []interface{}, error) {
+	if len(data) < 4 {
+		return nil, nil, errors.New("invalid revert data")
+	}
+
+	for _, e := range c.abi.Errors {
+		if !bytes.Equal(e.ID[:4], data[:4]) {
+			continue
+		}
+		values, err := e.Unpack(data)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to unpack error %v", e.Name)
+		}
+		return &e, values.([]interface{}), nil
+	}
+	return nil, nil, errors.Errorf("no error matches selector %x", data[:4])
+}
+
+func (c *BoundContract) topics(name string, query [][]interface{}) ([][]common.Hash, error) {
+	event, ok := c.abi.Events[name]
+	if !ok {
+		return nil, errors.Errorf("event %v not found", name)
+	}
+
+	if !event.Anonymous {
+		query = append([][]interface{}{{event.ID}}, query...)
+	}
+
+	topics, err := abi.MakeTopics(query...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make topics")
+	}
+	return topics, nil
+}
+
+// eth returns a copy of eth client using ctx if it is not nil
+func (c *BoundContract) eth(ctx context.Context) *client.RpcEthClient {
+	if ctx == nil {
+		return c.client.Eth
+	}
+	eth := *c.client.Eth
+	eth.SetContext(ctx)
+	return &eth
+}
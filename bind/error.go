@@ -0,0 +1,27 @@
+package bind
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	rpc "github.com/openweb3/go-rpc-provider"
+	"github.com/pkg/errors"
+)
+
+// RevertData returns the revert data carried by the json-rpc error of eth_call, eth_estimateGas
+// or eth_sendTransaction, false is returned if err is not a revert error with data.
+func RevertData(err error) ([]byte, bool) {
+	var jsonErr *rpc.JsonError
+	if !errors.As(err, &jsonErr) {
+		return nil, false
+	}
+
+	hex, ok := jsonErr.Data.(string)
+	if !ok {
+		return nil, false
+	}
+
+	data, decodeErr := hexutil.Decode(hex)
+	if decodeErr != nil || len(data) < 4 {
+		return nil, false
+	}
+	return data, true
+}
@@ -0,0 +1,29 @@
+package bind
+
+import (
+	"testing"
+
+	rpc "github.com/openweb3/go-rpc-provider"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRevertData(t *testing.T) {
+	a := assert.New(t)
+
+	err := errors.Wrap(&rpc.JsonError{Code: 3, Message: "execution reverted", Data: "0x82b4290000"}, "failed to estimate")
+	data, ok := RevertData(err)
+	a.True(ok)
+	a.Equal([]byte{0x82, 0xb4, 0x29, 0x00, 0x00}, data)
+
+	for _, err := range []error{
+		nil,
+		errors.New("execution reverted"),
+		&rpc.JsonError{Code: 3, Message: "execution reverted"},
+		&rpc.JsonError{Code: 3, Message: "execution reverted", Data: "0x01"},
+		&rpc.JsonError{Code: -32000, Message: "invalid", Data: map[string]interface{}{}},
+	} {
+		_, ok := RevertData(err)
+		a.False(ok)
+	}
+}
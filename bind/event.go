@@ -0,0 +1,59 @@
+package bind
+
+import (
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/openweb3/web3go/types"
+)
+
+// FilterEvents returns the events of contract matching the indexed arguments query, logs are parsed by parse.
+func FilterEvents[T any](c *BoundContract, opts *FilterOpts, name string, parse func(types.Log) (T, error), query ...[]interface{}) ([]T, error) {
+	logs, err := c.FilterLogs(opts, name, query...)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]T, 0, len(logs))
+	for _, log := range logs {
+		ev, err := parse(log)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, ev)
+	}
+	return events, nil
+}
+
+// WatchEvents subscribes to the events of contract matching the indexed arguments query, logs are parsed
+// by parse and sent to sink. The subscription fails if a log can not be parsed.
+func WatchEvents[T any](c *BoundContract, opts *WatchOpts, name string, sink chan<- T, parse func(types.Log) (T, error), query ...[]interface{}) (types.Subscription, error) {
+	logs := make(chan types.Log)
+	sub, err := c.WatchLogs(opts, name, logs, query...)
+	if err != nil {
+		return nil, err
+	}
+
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				ev, err := parse(log)
+				if err != nil {
+					return err
+				}
+
+				select {
+				case sink <- ev:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"math/big"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
@@ -22,14 +23,28 @@ import (
 // Client defines typed wrappers for the Ethereum RPC API.
 type Client struct {
 	*pproviders.MiddlewarableProvider
-	context context.Context
-	option  *ClientOption
-	Eth     *client.RpcEthClient
-	Trace   *client.RpcTraceClient
-	Parity  *client.RpcParityClient
-	Filter  *client.RpcFilterClient
-	Debug   *client.RpcDebugClient
-	TxPool  *client.RpcTxPoolClient
+	context  context.Context
+	option   *ClientOption
+	Eth      *client.RpcEthClient
+	Trace    *client.RpcTraceClient
+	Parity   *client.RpcParityClient
+	Filter   *client.RpcFilterClient
+	Debug    *client.RpcDebugClient
+	TxPool   *client.RpcTxPoolClient
+	Admin    *client.RpcAdminClient
+	Personal *client.RpcPersonalClient
+	Miner    *client.RpcMinerClient
+	Dev      *client.RpcDevClient
+	Ots      *client.RpcOtsClient
+
+	// cache is shared by copies of client created by WithContext
+	cache *clientCache
+}
+
+// clientCache caches the immutable chain data of the provider
+type clientCache struct {
+	mutex   sync.Mutex
+	chainId *big.Int
 }
 
 var (
@@ -91,6 +106,11 @@ func (client *Client) WithContext(ctx context.Context) *Client {
 	_client.Trace.SetContext(ctx)
 	_client.Debug.SetContext(ctx)
 	_client.TxPool.SetContext(ctx)
+	_client.Admin.SetContext(ctx)
+	_client.Personal.SetContext(ctx)
+	_client.Miner.SetContext(ctx)
+	_client.Dev.SetContext(ctx)
+	_client.Ots.SetContext(ctx)
 	return _client
 }
 
@@ -102,12 +122,22 @@ func (client *Client) copy() *Client {
 	trace := *client.Trace
 	debug := *client.Debug
 	txpool := *client.TxPool
+	admin := *client.Admin
+	personal := *client.Personal
+	miner := *client.Miner
+	dev := *client.Dev
+	ots := *client.Ots
 	_client.Eth = &eth
 	_client.Filter = &filter
 	_client.Parity = &parity
 	_client.Trace = &trace
 	_client.Debug = &debug
 	_client.TxPool = &txpool
+	_client.Admin = &admin
+	_client.Personal = &personal
+	_client.Miner = &miner
+	_client.Dev = &dev
+	_client.Ots = &ots
 	return &_client
 }
 
@@ -123,6 +153,12 @@ func (c *Client) SetProvider(p interfaces.Provider) {
 	c.Filter = client.NewRpcFilterClient(p)
 	c.Debug = client.NewRpcDebugClient(p)
 	c.TxPool = client.NewRpcTxPoolClient(p)
+	c.Admin = client.NewRpcAdminClient(p)
+	c.Personal = client.NewRpcPersonalClient(p)
+	c.Miner = client.NewRpcMinerClient(p)
+	c.Dev = client.NewRpcDevClient(p)
+	c.Ots = client.NewRpcOtsClient(p)
+	c.cache = &clientCache{}
 }
 
 func (c *Client) Provider() *pproviders.MiddlewarableProvider {
@@ -131,12 +167,30 @@ func (c *Client) Provider() *pproviders.MiddlewarableProvider {
 
 // GetSignerManager returns signer manager if exist in option, otherwise return error
 func (c *Client) GetSignerManager() (*signers.SignerManager, error) {
-	if c.option.SignerManager != nil {
+	if c.option != nil && c.option.SignerManager != nil {
 		return c.option.SignerManager, nil
 	}
 	return nil, ErrNotFound
 }
 
+// ChainId returns the chain id, it is requested from the chain once and cached.
+func (c *Client) ChainId() (*big.Int, error) {
+	c.cache.mutex.Lock()
+	defer c.cache.mutex.Unlock()
+
+	if c.cache.chainId == nil {
+		chainId, err := c.Eth.ChainId()
+		if err != nil {
+			return nil, err
+		}
+		if chainId == nil {
+			return nil, providers.ErrChainNotReady
+		}
+		c.cache.chainId = new(big.Int).SetUint64(*chainId)
+	}
+	return new(big.Int).Set(c.cache.chainId), nil
+}
+
 // ToClientForContract returns ClientForContract and SignerFn for use by abi-binding struct generated by abigen.
 // abigen is a source code generator to convert Ethereum contract definitions into easy to use, compile-time type-safe Go packages.
 // Please see https://geth.ethereum.org/docs/dapp/native-bindings page for details
@@ -147,7 +201,7 @@ func (c *Client) ToClientForContract() (*ClientForContract, bind.SignerFn) {
 	}
 
 	signFunc := func(addr common.Address, t *types.Transaction) (*types.Transaction, error) {
-		chainId, err := c.Eth.ChainId()
+		chainId, err := c.ChainId()
 		if err != nil {
 			return nil, err
 		}
@@ -155,7 +209,7 @@ func (c *Client) ToClientForContract() (*ClientForContract, bind.SignerFn) {
 		if err != nil {
 			return nil, err
 		}
-		return s.SignTransaction(t, new(big.Int).SetUint64(*chainId))
+		return s.SignTransaction(t, chainId)
 	}
 
 	return NewClientForContract(c), signFunc
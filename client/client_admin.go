@@ -0,0 +1,106 @@
+package client
+
+import (
+	"github.com/openweb3/go-rpc-provider/interfaces"
+	providers "github.com/openweb3/go-rpc-provider/provider_wrapper"
+	"github.com/openweb3/web3go/types"
+)
+
+// RpcAdminClient manages geth compatible nodes by admin_* methods, which are usually only enabled on trusted
+// endpoints.
+type RpcAdminClient struct {
+	BaseClient
+}
+
+func NewRpcAdminClient(provider interfaces.Provider) *RpcAdminClient {
+	_client := &RpcAdminClient{}
+	_client.MiddlewarableProvider = providers.NewMiddlewarableProvider(provider)
+	return _client
+}
+
+// Returns the node information, including the enode URL and protocol metadata.
+func (c *RpcAdminClient) NodeInfo() (val *types.AdminNodeInfo, err error) {
+	err = c.CallContext(c.getContext(), &val, "admin_nodeInfo")
+	return
+}
+
+// Returns the connected peers.
+func (c *RpcAdminClient) Peers() (val []*types.AdminPeerInfo, err error) {
+	err = c.CallContext(c.getContext(), &val, "admin_peers")
+	return
+}
+
+// Adds the enode URL as a static peer, which is reconnected whenever disconnected.
+func (c *RpcAdminClient) AddPeer(enode string) (val bool, err error) {
+	err = c.CallContext(c.getContext(), &val, "admin_addPeer", enode)
+	return
+}
+
+// Removes the static peer and disconnects it.
+func (c *RpcAdminClient) RemovePeer(enode string) (val bool, err error) {
+	err = c.CallContext(c.getContext(), &val, "admin_removePeer", enode)
+	return
+}
+
+// Adds the enode URL as a trusted peer, which is allowed to connect even if the peer limit is reached.
+func (c *RpcAdminClient) AddTrustedPeer(enode string) (val bool, err error) {
+	err = c.CallContext(c.getContext(), &val, "admin_addTrustedPeer", enode)
+	return
+}
+
+// Removes the enode URL from trusted peers, the peer is not disconnected.
+func (c *RpcAdminClient) RemoveTrustedPeer(enode string) (val bool, err error) {
+	err = c.CallContext(c.getContext(), &val, "admin_removeTrustedPeer", enode)
+	return
+}
+
+// Returns the data directory of node.
+func (c *RpcAdminClient) Datadir() (val string, err error) {
+	err = c.CallContext(c.getContext(), &val, "admin_datadir")
+	return
+}
+
+// Starts the HTTP RPC endpoint, nil arguments use the values configured on node. The apis, cors and vhosts are
+// comma separated lists.
+func (c *RpcAdminClient) StartHTTP(host *string, port *int, cors *string, apis *string, vhosts *string) (val bool, err error) {
+	err = c.CallContext(c.getContext(), &val, "admin_startHTTP", host, port, cors, apis, vhosts)
+	return
+}
+
+// Stops the HTTP RPC endpoint.
+func (c *RpcAdminClient) StopHTTP() (val bool, err error) {
+	err = c.CallContext(c.getContext(), &val, "admin_stopHTTP")
+	return
+}
+
+// Starts the WebSocket RPC endpoint, nil arguments use the values configured on node. The allowedOrigins and apis
+// are comma separated lists.
+func (c *RpcAdminClient) StartWS(host *string, port *int, allowedOrigins *string, apis *string) (val bool, err error) {
+	err = c.CallContext(c.getContext(), &val, "admin_startWS", host, port, allowedOrigins, apis)
+	return
+}
+
+// Stops the WebSocket RPC endpoint.
+func (c *RpcAdminClient) StopWS() (val bool, err error) {
+	err = c.CallContext(c.getContext(), &val, "admin_stopWS")
+	return
+}
+
+// Exports blocks from first to last of the chain to the file on node, all blocks are exported if first and last
+// are nil. The file is gzipped if it ends with ".gz".
+func (c *RpcAdminClient) ExportChain(file string, first *uint64, last *uint64) (val bool, err error) {
+	err = c.CallContext(c.getContext(), &val, "admin_exportChain", file, first, last)
+	return
+}
+
+// Imports blocks from the file on node, which is exported by ExportChain.
+func (c *RpcAdminClient) ImportChain(file string) (val bool, err error) {
+	err = c.CallContext(c.getContext(), &val, "admin_importChain", file)
+	return
+}
+
+// SubscribePeerEvents subscribes to events of peers, such as peers added and dropped.
+// Note: it is only supported by websocket and IPC providers.
+func (c *RpcAdminClient) SubscribePeerEvents(ch chan<- *types.PeerEvent) (types.Subscription, error) {
+	return c.Subscribe(c.getContext(), "admin", ch, "peerEvents")
+}
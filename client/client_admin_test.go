@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/params"
+	rpc "github.com/openweb3/go-rpc-provider"
+	"github.com/openweb3/web3go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// adminNode returns results in the format of geth.
+type adminNode struct {
+	peers []string
+}
+
+func (n *adminNode) NodeInfo() (*p2p.NodeInfo, error) {
+	info := &p2p.NodeInfo{ID: "1234", Name: "Geth/v1.15.11", Enode: "enode://1234@127.0.0.1:30303", ListenAddr: "[::]:30303"}
+	info.Ports.Discovery = 30303
+	info.Ports.Listener = 30303
+	info.Protocols = map[string]any{
+		"eth":  map[string]any{"network": 1, "genesis": params.MainnetGenesisHash, "config": params.MainnetChainConfig, "head": common.HexToHash("0x01")},
+		"snap": struct{}{},
+	}
+	return info, nil
+}
+
+func (n *adminNode) Peers() ([]*p2p.PeerInfo, error) {
+	var peers []*p2p.PeerInfo
+	for _, enode := range n.peers {
+		peer := &p2p.PeerInfo{Enode: enode, ID: "5678", Caps: []string{"eth/68", "snap/1"}}
+		peer.Network.Static = true
+		peer.Protocols = map[string]any{"eth": map[string]any{"version": 68}, "snap": "handshake"}
+		peers = append(peers, peer)
+	}
+	return peers, nil
+}
+
+func (n *adminNode) AddPeer(enode string) (bool, error) {
+	n.peers = append(n.peers, enode)
+	return true, nil
+}
+
+func (n *adminNode) PeerEvents(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, _ := rpc.NotifierFromContext(ctx)
+	sub := notifier.CreateSubscription()
+	go func() {
+		code, size := uint64(2), uint32(100)
+		notifier.Notify(sub.ID, &p2p.PeerEvent{Type: p2p.PeerEventTypeMsgRecv, Protocol: "eth", MsgCode: &code, MsgSize: &size})
+	}()
+	return sub, nil
+}
+
+func TestAdmin(t *testing.T) {
+	a := assert.New(t)
+
+	server := rpc.NewServer()
+	a.NoError(server.RegisterName("admin", &adminNode{}))
+	t.Cleanup(server.Stop)
+	admin := NewRpcAdminClient(rpc.DialInProc(server))
+
+	info, err := admin.NodeInfo()
+	a.NoError(err)
+	a.Equal(30303, info.Ports.Listener)
+	a.Equal(uint64(1), info.Protocols.Eth.Network)
+	a.Equal(params.MainnetGenesisHash, info.Protocols.Eth.Genesis)
+	a.Equal(params.MainnetChainConfig.ChainID, info.Protocols.Eth.Config.ChainID)
+
+	ok, err := admin.AddPeer("enode://5678@127.0.0.1:30304")
+	a.NoError(err)
+	a.True(ok)
+
+	peers, err := admin.Peers()
+	a.NoError(err)
+	a.Equal(1, len(peers))
+	a.Equal("enode://5678@127.0.0.1:30304", peers[0].Enode)
+	a.True(peers[0].Network.Static)
+	a.Equal(uint(68), peers[0].Protocols["eth"].Version)
+	a.Equal("handshake", peers[0].Protocols["snap"].State)
+
+	ch := make(chan *types.PeerEvent)
+	sub, err := admin.SubscribePeerEvents(ch)
+	a.NoError(err)
+	defer sub.Unsubscribe()
+
+	select {
+	case event := <-ch:
+		a.Equal(types.PeerEventTypeMsgRecv, event.Type)
+		a.Equal(uint64(2), *event.MsgCode)
+		a.Equal(uint32(100), *event.MsgSize)
+	case err := <-sub.Err():
+		a.Fail("subscription failed", err)
+	case <-time.After(time.Second):
+		a.Fail("timeout")
+	}
+}
@@ -4,10 +4,14 @@ import (
 	"encoding/json"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/openweb3/go-rpc-provider/interfaces"
 	providers "github.com/openweb3/go-rpc-provider/provider_wrapper"
 	"github.com/openweb3/web3go/types"
 	"github.com/openweb3/web3go/types/enums"
+	"github.com/pkg/errors"
 )
 
 type RpcDebugClient struct {
@@ -28,10 +32,21 @@ func (c *RpcDebugClient) TraceTransaction(tx_hash common.Hash, opts ...*types.Ge
 }
 
 func (c *RpcDebugClient) TraceBlockByHash(block_hash common.Hash, opts ...*types.GethDebugTracingOptions) (val []*types.GethTraceResult, err error) {
-	opt := get1stOpt(opts)
+	return c.traceBlock("debug_traceBlockByHash", block_hash, get1stOpt(opts))
+}
+
+func (c *RpcDebugClient) TraceBlockByNumber(blockNumber types.BlockNumber, opts ...*types.GethDebugTracingOptions) (val []*types.GethTraceResult, err error) {
+	return c.traceBlock("debug_traceBlockByNumber", blockNumber, get1stOpt(opts))
+}
+
+// TraceBadBlock traces transactions of the bad block rejected by node, see BadBlocks of debug_getBadBlocks.
+func (c *RpcDebugClient) TraceBadBlock(block_hash common.Hash, opts ...*types.GethDebugTracingOptions) (val []*types.GethTraceResult, err error) {
+	return c.traceBlock("debug_traceBadBlock", block_hash, get1stOpt(opts))
+}
 
+func (c *RpcDebugClient) traceBlock(method string, block any, opt *types.GethDebugTracingOptions) (val []*types.GethTraceResult, err error) {
 	var tmpVal []any
-	err = c.CallContext(c.getContext(), &tmpVal, "debug_traceBlockByHash", block_hash, opt)
+	err = c.CallContext(c.getContext(), &tmpVal, method, block, opt)
 	if err != nil {
 		return nil, err
 	}
@@ -50,39 +65,202 @@ func (c *RpcDebugClient) TraceBlockByHash(block_hash common.Hash, opts ...*types
 	return val, err
 }
 
-func (c *RpcDebugClient) TraceBlockByNumber(blockNumber types.BlockNumber, opts ...*types.GethDebugTracingOptions) (val []*types.GethTraceResult, err error) {
+// TraceCall traces the call on the state of block, nil block means the latest block. State and block fields could
+// be overridden by StateOverrides and BlockOverrides of option.
+func (c *RpcDebugClient) TraceCall(request types.CallRequest, blockNum *types.BlockNumberOrHash, opts ...*types.GethDebugTracingOptions) (val *types.GethTrace, err error) {
 	opt := get1stOpt(opts)
+	val = &types.GethTrace{Type: getGethTraceTypeByOpt(opt)}
+	err = c.CallContext(c.getContext(), &val, "debug_traceCall", request, getRealBlockNumberOrHash(blockNum), opt)
+	return
+}
 
-	var tmpVal []any
-	err = c.CallContext(c.getContext(), &tmpVal, "debug_traceBlockByNumber", blockNumber, opt)
-	if err != nil {
+// TraceCallMany traces bundles of calls in sequence, each call is executed on the state of previous calls, and the
+// results are grouped by bundles. The state of latest block is used if stateContext is nil, and state could be
+// overridden by StateOverrides of option. It is supported by erigon and reth.
+func (c *RpcDebugClient) TraceCallMany(bundles []types.CallBundle, stateContext *types.StateContext, opts ...*types.GethDebugTracingOptions) (val [][]*types.GethTrace, err error) {
+	if stateContext == nil {
+		stateContext = &types.StateContext{BlockNumber: *getRealBlockNumberOrHash(nil)}
+	}
+	opt := get1stOpt(opts)
+
+	var tmpVal [][]json.RawMessage
+	if err = c.CallContext(c.getContext(), &tmpVal, "debug_traceCallMany", bundles, stateContext, opt); err != nil {
 		return nil, err
 	}
 
-	val = make([]*types.GethTraceResult, len(tmpVal))
 	tracerType := getGethTraceTypeByOpt(opt)
-	for i, v := range tmpVal {
-		val[i] = &types.GethTraceResult{TracerType: tracerType}
-
-		b, _ := json.Marshal(v)
-		if err := val[i].UnmarshalJSON(b); err != nil {
-			return nil, err
+	val = make([][]*types.GethTrace, len(tmpVal))
+	for i := range tmpVal {
+		val[i] = make([]*types.GethTrace, len(tmpVal[i]))
+		for j := range tmpVal[i] {
+			val[i][j] = &types.GethTrace{Type: tracerType}
+			if err := val[i][j].UnmarshalJSON(tmpVal[i][j]); err != nil {
+				return nil, errors.WithMessagef(err, "failed to unmarshal trace of bundle %v call %v", i, j)
+			}
 		}
 	}
+	return val, nil
+}
 
-	return val, err
+// StandardTraceBlockToFile writes the struct logs of transactions in block to files on node, and returns the
+// file names.
+func (c *RpcDebugClient) StandardTraceBlockToFile(block_hash common.Hash, opts ...*types.GethStdTraceOptions) (val []string, err error) {
+	var opt *types.GethStdTraceOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	err = c.CallContext(c.getContext(), &val, "debug_standardTraceBlockToFile", block_hash, opt)
+	return
 }
 
-func (c *RpcDebugClient) TraceCall(request types.CallRequest, block_number *types.BlockNumber, opts ...*types.GethDebugTracingOptions) (val *types.GethTrace, err error) {
-	opt := get1stOpt(opts)
-	val = &types.GethTrace{Type: getGethTraceTypeByOpt(opt)}
-	err = c.CallContext(c.getContext(), &val, "debug_traceCall", request, block_number, opt)
+// IntermediateRoots returns the state roots after each transaction in block is executed.
+func (c *RpcDebugClient) IntermediateRoots(block_hash common.Hash, opts ...*types.GethDebugTracingOptions) (val []common.Hash, err error) {
+	err = c.CallContext(c.getContext(), &val, "debug_intermediateRoots", block_hash, get1stOpt(opts))
+	return
+}
+
+// StorageRangeAt returns at most maxResult storage slots of contract, starting from the key hash keyStart, in the
+// state after the transaction at txIndex of block is executed. Use StorageRangeIterator to iterate all storage.
+func (c *RpcDebugClient) StorageRangeAt(blockNum *types.BlockNumberOrHash, txIndex int, contract common.Address, keyStart []byte, maxResult int) (val *types.StorageRangeResult, err error) {
+	err = c.CallContext(c.getContext(), &val, "debug_storageRangeAt", getRealBlockNumberOrHash(blockNum), txIndex, contract, hexutil.Bytes(keyStart), maxResult)
+	return
+}
+
+// AccountRange returns at most maxResults accounts in the state of block, starting from the address hash start.
+// Code and storage of accounts are skipped if noCode and noStorage are set, and accounts without address
+// preimages are included if incompletes is set. Use AccountRangeIterator to iterate all accounts.
+func (c *RpcDebugClient) AccountRange(blockNum *types.BlockNumberOrHash, start []byte, maxResults int, noCode, noStorage, incompletes bool) (val *types.StateDump, err error) {
+	err = c.CallContext(c.getContext(), &val, "debug_accountRange", getRealBlockNumberOrHash(blockNum), hexutil.Bytes(start), maxResults, noCode, noStorage, incompletes)
+	return
+}
+
+// DumpBlock returns all accounts in the state of block, use AccountRangeIterator for large states.
+func (c *RpcDebugClient) DumpBlock(blockNumber types.BlockNumber) (val *types.StateDump, err error) {
+	err = c.CallContext(c.getContext(), &val, "debug_dumpBlock", blockNumber)
+	return
+}
+
+// ModifiedAccountsByNumber returns accounts modified after block startNum until block endNum, or the accounts
+// modified in block startNum if endNum is nil.
+func (c *RpcDebugClient) ModifiedAccountsByNumber(startNum uint64, endNum *uint64) (val []common.Address, err error) {
+	err = c.CallContext(c.getContext(), &val, "debug_getModifiedAccountsByNumber", startNum, endNum)
+	return
+}
+
+// ModifiedAccountsByHash returns accounts modified after block startHash until block endHash, or the accounts
+// modified in block startHash if endHash is nil.
+func (c *RpcDebugClient) ModifiedAccountsByHash(startHash common.Hash, endHash *common.Hash) (val []common.Address, err error) {
+	err = c.CallContext(c.getContext(), &val, "debug_getModifiedAccountsByHash", startHash, endHash)
 	return
 }
 
+// StorageRangeIterator returns an iterator of storage pages of contract in the state after the transaction at
+// txIndex of block is executed, each page contains at most pageSize slots.
+//
+// Note the block should be specified by hash, otherwise pages may be read from different blocks.
+func (c *RpcDebugClient) StorageRangeIterator(blockNum *types.BlockNumberOrHash, txIndex int, contract common.Address, pageSize int) *StorageRangeIterator {
+	return &StorageRangeIterator{
+		client:   c,
+		blockNum: getRealBlockNumberOrHash(blockNum),
+		txIndex:  txIndex,
+		contract: contract,
+		pageSize: pageSize,
+	}
+}
+
+// AccountRangeIterator returns an iterator of account pages in the state of block, each page contains at most
+// pageSize accounts, see AccountRange for noCode, noStorage and incompletes.
+//
+// Note the block should be specified by hash, otherwise pages may be read from different blocks.
+func (c *RpcDebugClient) AccountRangeIterator(blockNum *types.BlockNumberOrHash, pageSize int, noCode, noStorage, incompletes bool) *AccountRangeIterator {
+	return &AccountRangeIterator{
+		client:      c,
+		blockNum:    getRealBlockNumberOrHash(blockNum),
+		pageSize:    pageSize,
+		noCode:      noCode,
+		noStorage:   noStorage,
+		incompletes: incompletes,
+	}
+}
+
+// RawHeader returns the header of block decoded from the RLP of debug_getRawHeader, use Block.VerifyRawHeader to
+// check the block returned by eth_getBlockByNumber against it.
+func (c *RpcDebugClient) RawHeader(blockNum *types.BlockNumberOrHash) (val *ethtypes.Header, err error) {
+	var raw hexutil.Bytes
+	if err = c.CallContext(c.getContext(), &raw, "debug_getRawHeader", getRealBlockNumberOrHash(blockNum)); err != nil {
+		return
+	}
+
+	val = new(ethtypes.Header)
+	if err = rlp.DecodeBytes(raw, val); err != nil {
+		return nil, errors.Wrap(err, "failed to decode header")
+	}
+	return
+}
+
+// RawBlock returns the block decoded from the RLP of debug_getRawBlock, use Block.VerifyRaw to check the block
+// returned by eth_getBlockByNumber against it.
+func (c *RpcDebugClient) RawBlock(blockNum *types.BlockNumberOrHash) (val *ethtypes.Block, err error) {
+	var raw hexutil.Bytes
+	if err = c.CallContext(c.getContext(), &raw, "debug_getRawBlock", getRealBlockNumberOrHash(blockNum)); err != nil {
+		return
+	}
+
+	val = new(ethtypes.Block)
+	if err = rlp.DecodeBytes(raw, val); err != nil {
+		return nil, errors.Wrap(err, "failed to decode block")
+	}
+	return
+}
+
+// RawReceipts returns the receipts of block decoded from the consensus encoding of debug_getRawReceipts, which
+// contain no derived fields such as hashes and gas used. Use types.VerifyRawReceipts to check the receipts
+// returned by eth_getBlockReceipts against them.
+func (c *RpcDebugClient) RawReceipts(blockNum *types.BlockNumberOrHash) (val []*ethtypes.Receipt, err error) {
+	var raw []hexutil.Bytes
+	if err = c.CallContext(c.getContext(), &raw, "debug_getRawReceipts", getRealBlockNumberOrHash(blockNum)); err != nil {
+		return
+	}
+
+	val = make([]*ethtypes.Receipt, len(raw))
+	for i := range raw {
+		val[i] = new(ethtypes.Receipt)
+		if err = val[i].UnmarshalBinary(raw[i]); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode receipt %v", i)
+		}
+	}
+	return
+}
+
+// RawTransaction returns the transaction decoded from the binary encoding of debug_getRawTransaction, or nil if
+// the transaction is not found. Use TransactionDetail.VerifyRaw to check the transaction returned by
+// eth_getTransactionByHash against it.
+func (c *RpcDebugClient) RawTransaction(txHash common.Hash) (val *ethtypes.Transaction, err error) {
+	var raw hexutil.Bytes
+	if err = c.CallContext(c.getContext(), &raw, "debug_getRawTransaction", txHash); err != nil {
+		return
+	}
+	return decodeRawTransaction(raw)
+}
+
+// decodeRawTransaction decodes the binary encoding of transaction, nil is returned if raw is empty as the
+// transaction is not found.
+func decodeRawTransaction(raw []byte) (*ethtypes.Transaction, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	tx := new(ethtypes.Transaction)
+	if err := tx.UnmarshalBinary(raw); err != nil {
+		return nil, errors.Wrap(err, "failed to decode transaction")
+	}
+	return tx, nil
+}
+
 func getGethTraceTypeByOpt(opts *types.GethDebugTracingOptions) enums.GethTraceType {
 	t := enums.GETH_TRACE_DEFAULT
-	if opts != nil {
+	// the struct logger is used if tracer is empty, such as options with overrides only
+	if opts != nil && opts.Tracer != "" {
 		t = enums.ParseGethTraceType(opts.Tracer)
 	}
 	return t
@@ -94,3 +272,99 @@ func get1stOpt(opts []*types.GethDebugTracingOptions) *types.GethDebugTracingOpt
 	}
 	return opts[0]
 }
+
+// StorageRangeIterator iterates storage of contract page by page by debug_storageRangeAt.
+//
+//	it := client.Debug.StorageRangeIterator(&block, 0, contract, 256)
+//	for it.Next() {
+//		for hash, entry := range it.Page().Storage { ... }
+//	}
+//	if err := it.Err(); err != nil { ... }
+type StorageRangeIterator struct {
+	client   *RpcDebugClient
+	blockNum *types.BlockNumberOrHash
+	txIndex  int
+	contract common.Address
+	pageSize int
+
+	start []byte
+	done  bool
+	page  *types.StorageRangeResult
+	err   error
+}
+
+// Next requests the next page, it returns false if there is no more pages or an error occurs.
+func (it *StorageRangeIterator) Next() bool {
+	if it.done {
+		return false
+	}
+
+	it.page, it.err = it.client.StorageRangeAt(it.blockNum, it.txIndex, it.contract, it.start, it.pageSize)
+	if it.err != nil {
+		it.done = true
+		return false
+	}
+
+	if it.page == nil || it.page.NextKey == nil {
+		it.done = true
+	} else {
+		it.start = it.page.NextKey.Bytes()
+	}
+	return true
+}
+
+// Page returns the current page.
+func (it *StorageRangeIterator) Page() *types.StorageRangeResult {
+	return it.page
+}
+
+// Err returns the error occurred when requesting pages.
+func (it *StorageRangeIterator) Err() error {
+	return it.err
+}
+
+// AccountRangeIterator iterates accounts of state page by page by debug_accountRange, see StorageRangeIterator
+// for usage.
+type AccountRangeIterator struct {
+	client      *RpcDebugClient
+	blockNum    *types.BlockNumberOrHash
+	pageSize    int
+	noCode      bool
+	noStorage   bool
+	incompletes bool
+
+	start []byte
+	done  bool
+	page  *types.StateDump
+	err   error
+}
+
+// Next requests the next page, it returns false if there is no more pages or an error occurs.
+func (it *AccountRangeIterator) Next() bool {
+	if it.done {
+		return false
+	}
+
+	it.page, it.err = it.client.AccountRange(it.blockNum, it.start, it.pageSize, it.noCode, it.noStorage, it.incompletes)
+	if it.err != nil {
+		it.done = true
+		return false
+	}
+
+	if it.page == nil || len(it.page.Next) == 0 {
+		it.done = true
+	} else {
+		it.start = it.page.Next
+	}
+	return true
+}
+
+// Page returns the current page.
+func (it *AccountRangeIterator) Page() *types.StateDump {
+	return it.page
+}
+
+// Err returns the error occurred when requesting pages.
+func (it *AccountRangeIterator) Err() error {
+	return it.err
+}
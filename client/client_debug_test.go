@@ -0,0 +1,276 @@
+package client
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/ethereum/go-ethereum/triedb"
+	"github.com/holiman/uint256"
+	rpc "github.com/openweb3/go-rpc-provider"
+	"github.com/openweb3/web3go/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type rawChain struct {
+	block    *ethtypes.Block
+	receipts []*ethtypes.Receipt
+}
+
+func (c *rawChain) GetRawHeader(blockNum types.BlockNumberOrHash) (hexutil.Bytes, error) {
+	return rlp.EncodeToBytes(c.block.Header())
+}
+
+func (c *rawChain) GetRawBlock(blockNum types.BlockNumberOrHash) (hexutil.Bytes, error) {
+	return rlp.EncodeToBytes(c.block)
+}
+
+func (c *rawChain) GetRawReceipts(blockNum types.BlockNumberOrHash) ([]hexutil.Bytes, error) {
+	var result []hexutil.Bytes
+	for _, r := range c.receipts {
+		encoded, err := r.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, encoded)
+	}
+	return result, nil
+}
+
+func (c *rawChain) GetRawTransaction(hash common.Hash) (hexutil.Bytes, error) {
+	if tx := c.block.Transaction(hash); tx != nil {
+		return tx.MarshalBinary()
+	}
+	return nil, nil
+}
+
+func (c *rawChain) GetRawTransactionByHash(hash common.Hash) (hexutil.Bytes, error) {
+	return c.GetRawTransaction(hash)
+}
+
+func TestRawData(t *testing.T) {
+	a := assert.New(t)
+
+	key, _ := crypto.GenerateKey()
+	to := common.HexToAddress("0x1234")
+	tx := ethtypes.MustSignNewTx(key, ethtypes.LatestSignerForChainID(big.NewInt(1)), &ethtypes.DynamicFeeTx{ChainID: big.NewInt(1), To: &to, Gas: 21000, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(2)})
+	receipt := &ethtypes.Receipt{Type: ethtypes.DynamicFeeTxType, Status: ethtypes.ReceiptStatusSuccessful, CumulativeGasUsed: 21000}
+	header := &ethtypes.Header{Number: big.NewInt(1), Difficulty: new(big.Int), GasLimit: 30_000_000, GasUsed: 21000}
+	chain := &rawChain{
+		block:    ethtypes.NewBlock(header, &ethtypes.Body{Transactions: ethtypes.Transactions{tx}}, []*ethtypes.Receipt{receipt}, trie.NewStackTrie(nil)),
+		receipts: []*ethtypes.Receipt{receipt},
+	}
+
+	server := rpc.NewServer()
+	a.NoError(server.RegisterName("debug", chain))
+	a.NoError(server.RegisterName("eth", chain))
+	t.Cleanup(server.Stop)
+	debug := NewRpcDebugClient(rpc.DialInProc(server))
+	eth := NewRpcEthClient(rpc.DialInProc(server))
+
+	rawHeader, err := debug.RawHeader(nil)
+	a.NoError(err)
+	a.Equal(chain.block.Hash(), rawHeader.Hash())
+
+	rawBlock, err := debug.RawBlock(nil)
+	a.NoError(err)
+	a.Equal(chain.block.Hash(), rawBlock.Hash())
+	a.Equal(tx.Hash(), rawBlock.Transactions()[0].Hash())
+
+	rawReceipts, err := debug.RawReceipts(nil)
+	a.NoError(err)
+	a.NoError(types.VerifyRawReceipts(chain.block.ReceiptHash(), []*types.Receipt{{
+		CumulativeGasUsed: 21000,
+		LogsBloom:         receipt.Bloom,
+		Status:            types.Pointer(uint64(1)),
+		Type:              types.Pointer(uint64(ethtypes.DynamicFeeTxType)),
+	}}, rawReceipts))
+
+	rawTx, err := debug.RawTransaction(tx.Hash())
+	a.NoError(err)
+	a.Equal(tx.Hash(), rawTx.Hash())
+
+	rawTx, err = eth.RawTransactionByHash(tx.Hash())
+	a.NoError(err)
+	a.Equal(tx.Hash(), rawTx.Hash())
+
+	rawTx, err = eth.RawTransactionByHash(common.Hash{})
+	a.NoError(err)
+	a.Nil(rawTx)
+}
+
+type stateChain struct {
+	db      state.Database
+	root    common.Hash
+	storage map[common.Hash]common.Hash // keyed by hash of storage key
+}
+
+func (c *stateChain) AccountRange(blockNum types.BlockNumberOrHash, start hexutil.Bytes, maxResults int, noCode, noStorage, incompletes bool) (state.Dump, error) {
+	statedb, err := state.New(c.root, c.db)
+	if err != nil {
+		return state.Dump{}, err
+	}
+	return statedb.RawDump(&state.DumpConfig{
+		SkipCode:          noCode,
+		SkipStorage:       noStorage,
+		OnlyWithAddresses: !incompletes,
+		Start:             start,
+		Max:               uint64(maxResults),
+	}), nil
+}
+
+// StorageRangeAt returns storage in the format of geth.
+func (c *stateChain) StorageRangeAt(blockNum types.BlockNumberOrHash, txIndex int, contract common.Address, keyStart hexutil.Bytes, maxResult int) (map[string]any, error) {
+	var hashes []common.Hash
+	for hash := range c.storage {
+		if bytes.Compare(hash[:], common.BytesToHash(keyStart).Bytes()) >= 0 || len(keyStart) == 0 {
+			hashes = append(hashes, hash)
+		}
+	}
+	sort.Slice(hashes, func(i, j int) bool { return bytes.Compare(hashes[i][:], hashes[j][:]) < 0 })
+
+	storage := map[common.Hash]any{}
+	for i := 0; i < maxResult && i < len(hashes); i++ {
+		storage[hashes[i]] = map[string]any{"key": nil, "value": c.storage[hashes[i]]}
+	}
+
+	var next *common.Hash
+	if len(hashes) > maxResult {
+		next = &hashes[maxResult]
+	}
+	return map[string]any{"storage": storage, "nextKey": next}, nil
+}
+
+func (c *stateChain) GetModifiedAccountsByNumber(startNum uint64, endNum *uint64) ([]common.Address, error) {
+	if endNum == nil {
+		return []common.Address{common.BigToAddress(new(big.Int).SetUint64(startNum))}, nil
+	}
+	return []common.Address{common.BigToAddress(new(big.Int).SetUint64(*endNum))}, nil
+}
+
+func TestRangeIterators(t *testing.T) {
+	a := assert.New(t)
+
+	db := state.NewDatabase(triedb.NewDatabase(rawdb.NewMemoryDatabase(), &triedb.Config{Preimages: true}), nil)
+	statedb, err := state.New(ethtypes.EmptyRootHash, db)
+	a.NoError(err)
+
+	contract := common.HexToAddress("0xc0de")
+	for i := 1; i <= 5; i++ {
+		statedb.AddBalance(common.BigToAddress(big.NewInt(int64(i))), uint256.NewInt(uint64(i)*1000), tracing.BalanceChangeUnspecified)
+	}
+	statedb.SetCode(contract, []byte{0x60, 0x00})
+	statedb.SetState(contract, common.HexToHash("0x01"), common.HexToHash("0xff"))
+	root, err := statedb.Commit(1, false, false)
+	a.NoError(err)
+
+	chain := &stateChain{db: db, root: root, storage: map[common.Hash]common.Hash{}}
+	for i := 1; i <= 5; i++ {
+		chain.storage[crypto.Keccak256Hash(common.BigToHash(big.NewInt(int64(i))).Bytes())] = common.BigToHash(big.NewInt(int64(i)))
+	}
+
+	server := rpc.NewServer()
+	a.NoError(server.RegisterName("debug", chain))
+	t.Cleanup(server.Stop)
+	debug := NewRpcDebugClient(rpc.DialInProc(server))
+
+	// accounts
+	accounts := map[string]*types.DumpAccount{}
+	pages := 0
+	it := debug.AccountRangeIterator(nil, 2, false, false, false)
+	for it.Next() {
+		pages++
+		a.Equal(root, it.Page().Root)
+		for k, v := range it.Page().Accounts {
+			accounts[k] = v
+		}
+	}
+	a.NoError(it.Err())
+	a.Equal(3, pages)
+	a.Equal(6, len(accounts))
+	a.Equal(big.NewInt(3000), accounts[common.BigToAddress(big.NewInt(3)).Hex()].Balance)
+	a.Equal([]byte{0x60, 0x00}, accounts[contract.Hex()].Code)
+	a.Equal(common.HexToHash("0xff"), accounts[contract.Hex()].Storage[common.HexToHash("0x01")])
+
+	// storage
+	storage := map[common.Hash]common.Hash{}
+	pages = 0
+	storageIt := debug.StorageRangeIterator(nil, 0, contract, 2)
+	for storageIt.Next() {
+		pages++
+		for k, v := range storageIt.Page().Storage {
+			storage[k] = v.Value
+		}
+	}
+	a.NoError(storageIt.Err())
+	a.Equal(3, pages)
+	a.Equal(chain.storage, storage)
+
+	modified, err := debug.ModifiedAccountsByNumber(7, nil)
+	a.NoError(err)
+	a.Equal([]common.Address{common.BigToAddress(big.NewInt(7))}, modified)
+}
+
+type traceCallChain struct{}
+
+// TraceCall returns the overridden balance of sender as gas of struct logger.
+func (c *traceCallChain) TraceCall(request types.CallRequest, blockNum types.BlockNumberOrHash, opt *types.GethDebugTracingOptions) (*types.DefaultFrame, error) {
+	if opt == nil || opt.StateOverrides == nil || opt.BlockOverrides == nil {
+		return nil, errors.New("overrides required")
+	}
+	balance := (*opt.StateOverrides)[*request.From].Balance
+	return &types.DefaultFrame{Gas: balance.ToInt().Uint64() + uint64(*opt.BlockOverrides.GasLimit)}, nil
+}
+
+// TraceCallMany returns the index of bundle and call as gas of struct logger.
+func (c *traceCallChain) TraceCallMany(bundles []types.CallBundle, stateContext types.StateContext, opt *types.GethDebugTracingOptions) ([][]*types.DefaultFrame, error) {
+	if _, ok := stateContext.BlockNumber.Number(); !ok {
+		return nil, errors.New("block number required")
+	}
+
+	result := make([][]*types.DefaultFrame, len(bundles))
+	for i, bundle := range bundles {
+		for j := range bundle.Transactions {
+			result[i] = append(result[i], &types.DefaultFrame{Gas: uint64(i*10 + j)})
+		}
+	}
+	return result, nil
+}
+
+func TestTraceCallWithOverrides(t *testing.T) {
+	a := assert.New(t)
+
+	server := rpc.NewServer()
+	a.NoError(server.RegisterName("debug", &traceCallChain{}))
+	t.Cleanup(server.Stop)
+	debug := NewRpcDebugClient(rpc.DialInProc(server))
+
+	from := common.HexToAddress("0x01")
+	trace, err := debug.TraceCall(types.CallRequest{From: &from}, nil, &types.GethDebugTracingOptions{
+		StateOverrides: &types.StateOverride{from: {Balance: (*hexutil.Big)(big.NewInt(100))}},
+		BlockOverrides: &types.BlockOverrides{GasLimit: (*hexutil.Uint64)(types.Pointer(uint64(5)))},
+	})
+	a.NoError(err)
+	a.Equal(uint64(105), trace.Default.Gas)
+
+	traces, err := debug.TraceCallMany([]types.CallBundle{
+		{Transactions: []types.CallRequest{{From: &from}, {From: &from}}},
+		{Transactions: []types.CallRequest{{From: &from}}},
+	}, nil)
+	a.NoError(err)
+	a.Equal(2, len(traces))
+	a.Equal(2, len(traces[0]))
+	a.Equal(uint64(1), traces[0][1].Default.Gas)
+	a.Equal(uint64(10), traces[1][0].Default.Gas)
+}
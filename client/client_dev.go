@@ -0,0 +1,186 @@
+package client
+
+import (
+	stderrors "errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/openweb3/go-rpc-provider/interfaces"
+	providers "github.com/openweb3/go-rpc-provider/provider_wrapper"
+	"github.com/openweb3/web3go/types"
+	"github.com/pkg/errors"
+)
+
+var ErrSnapshotNotReverted = errors.New("snapshot not reverted")
+
+// RpcDevClient controls dev nodes such as anvil and hardhat by evm_* methods and the node specific methods, which
+// are anvil_* by default and could be changed by SetNamespace.
+type RpcDevClient struct {
+	BaseClient
+	namespace types.DevNamespace
+}
+
+func NewRpcDevClient(provider interfaces.Provider) *RpcDevClient {
+	_client := &RpcDevClient{namespace: types.DevNamespaceAnvil}
+	_client.MiddlewarableProvider = providers.NewMiddlewarableProvider(provider)
+	return _client
+}
+
+// SetNamespace sets the namespace of node specific methods, such as SetBalance and ImpersonateAccount.
+func (c *RpcDevClient) SetNamespace(namespace types.DevNamespace) {
+	c.namespace = namespace
+}
+
+// call ignores the result, which is null on anvil and true on hardhat.
+func (c *RpcDevClient) call(method string, args ...interface{}) error {
+	var val interface{}
+	return c.CallContext(c.getContext(), &val, method, args...)
+}
+
+func (c *RpcDevClient) method(name string) string {
+	return string(c.namespace) + "_" + name
+}
+
+// Takes a snapshot of the chain and returns its id, which is used to revert the chain by Revert.
+func (c *RpcDevClient) Snapshot() (val *big.Int, err error) {
+	var _val *hexutil.Big
+	err = c.CallContext(c.getContext(), &_val, "evm_snapshot")
+	val = (*big.Int)(_val)
+	return
+}
+
+// Reverts the chain to the snapshot, the snapshot and the ones taken after it are removed. Returns false if the
+// snapshot is not found.
+func (c *RpcDevClient) Revert(snapshotId *big.Int) (val bool, err error) {
+	err = c.CallContext(c.getContext(), &val, "evm_revert", (*hexutil.Big)(snapshotId))
+	return
+}
+
+// Mines a block, the timestamp of block is determined by node if timestamp is nil.
+func (c *RpcDevClient) Mine(timestamp *uint64) error {
+	if timestamp == nil {
+		return c.call("evm_mine")
+	}
+	return c.call("evm_mine", hexutil.Uint64(*timestamp))
+}
+
+// Mines blocks, the interval of timestamps between blocks is 1 second if interval is nil.
+func (c *RpcDevClient) MineBlocks(blocks uint64, interval *uint64) error {
+	if interval == nil {
+		return c.call(c.method("mine"), hexutil.Uint64(blocks))
+	}
+	return c.call(c.method("mine"), hexutil.Uint64(blocks), hexutil.Uint64(*interval))
+}
+
+// Increases the timestamp of following blocks by seconds.
+func (c *RpcDevClient) IncreaseTime(seconds uint64) error {
+	return c.call("evm_increaseTime", seconds)
+}
+
+// Sets the timestamp of the next block.
+func (c *RpcDevClient) SetNextBlockTimestamp(timestamp uint64) error {
+	return c.call("evm_setNextBlockTimestamp", timestamp)
+}
+
+// Enables or disables mining a block for each transaction.
+func (c *RpcDevClient) SetAutomine(enabled bool) error {
+	return c.call("evm_setAutomine", enabled)
+}
+
+// Sets the balance of account.
+func (c *RpcDevClient) SetBalance(account common.Address, balance *big.Int) error {
+	return c.call(c.method("setBalance"), account, (*hexutil.Big)(balance))
+}
+
+// Sets the code of account.
+func (c *RpcDevClient) SetCode(account common.Address, code []byte) error {
+	return c.call(c.method("setCode"), account, hexutil.Bytes(code))
+}
+
+// Sets the nonce of account.
+func (c *RpcDevClient) SetNonce(account common.Address, nonce uint64) error {
+	return c.call(c.method("setNonce"), account, hexutil.Uint64(nonce))
+}
+
+// Sets the value of storage slot of account.
+func (c *RpcDevClient) SetStorageAt(account common.Address, slot common.Hash, value common.Hash) error {
+	// hardhat requires the slot to be a quantity without leading zeros
+	return c.call(c.method("setStorageAt"), account, (*hexutil.Big)(slot.Big()), value)
+}
+
+// Allows sending transactions from account without its private key by SendUnsignedTransaction.
+func (c *RpcDevClient) ImpersonateAccount(account common.Address) error {
+	return c.call(c.method("impersonateAccount"), account)
+}
+
+// Stops impersonating account.
+func (c *RpcDevClient) StopImpersonatingAccount(account common.Address) error {
+	return c.call(c.method("stopImpersonatingAccount"), account)
+}
+
+// Resets the chain to a fresh local chain, or forks from the remote chain specified by options.
+func (c *RpcDevClient) Reset(options *types.DevResetOptions) error {
+	if options == nil {
+		return c.call(c.method("reset"))
+	}
+	return c.call(c.method("reset"), options)
+}
+
+// SendUnsignedTransaction sends the transaction by eth_sendTransaction without signing it by the SignerManager
+// of client, so that it is signed by node. It is used to send transactions from impersonated accounts.
+func (c *RpcDevClient) SendUnsignedTransaction(args types.TransactionArgs) (val common.Hash, err error) {
+	err = c.CallContext(types.WithoutSigning(c.getContext()), &val, "eth_sendTransaction", args)
+	return
+}
+
+// WithSnapshot takes a snapshot, calls fn and reverts the chain to the snapshot after fn returns or panics, so that
+// changes made by fn are discarded. Errors of fn and reverting are both returned.
+func (c *RpcDevClient) WithSnapshot(fn func() error) (err error) {
+	revert, err := c.SnapshotScope()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if revertErr := revert(); revertErr != nil {
+			err = stderrors.Join(err, revertErr)
+		}
+	}()
+	return fn()
+}
+
+// SnapshotScope takes a snapshot and returns the function to revert the chain to it, which is usually registered
+// by t.Cleanup in tests.
+func (c *RpcDevClient) SnapshotScope() (revert func() error, err error) {
+	id, err := c.Snapshot()
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to take snapshot")
+	}
+
+	return func() error {
+		ok, err := c.Revert(id)
+		if err != nil {
+			return errors.WithMessagef(err, "failed to revert to snapshot %v", id)
+		}
+		if !ok {
+			return errors.WithMessagef(ErrSnapshotNotReverted, "snapshot %v", id)
+		}
+		return nil
+	}, nil
+}
+
+// WithImpersonation impersonates account, calls fn and stops impersonating account after fn returns or panics.
+// Errors of fn and stopping impersonating are both returned.
+func (c *RpcDevClient) WithImpersonation(account common.Address, fn func() error) (err error) {
+	if err := c.ImpersonateAccount(account); err != nil {
+		return errors.WithMessagef(err, "failed to impersonate account %v", account)
+	}
+
+	defer func() {
+		if stopErr := c.StopImpersonatingAccount(account); stopErr != nil {
+			err = stderrors.Join(err, errors.WithMessagef(stopErr, "failed to stop impersonating account %v", account))
+		}
+	}()
+	return fn()
+}
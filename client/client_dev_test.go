@@ -0,0 +1,143 @@
+package client
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	rpc "github.com/openweb3/go-rpc-provider"
+	"github.com/openweb3/web3go/providers"
+	"github.com/openweb3/web3go/signers"
+	"github.com/openweb3/web3go/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// devChain implements methods of anvil with a single account balance as the state.
+type devChain struct {
+	balance      *big.Int
+	snapshots    []*big.Int
+	impersonated map[common.Address]bool
+}
+
+func (c *devChain) Snapshot() hexutil.Uint64 {
+	c.snapshots = append(c.snapshots, new(big.Int).Set(c.balance))
+	return hexutil.Uint64(len(c.snapshots) - 1)
+}
+
+func (c *devChain) Revert(id hexutil.Uint64) bool {
+	if int(id) >= len(c.snapshots) {
+		return false
+	}
+	c.balance = c.snapshots[id]
+	c.snapshots = c.snapshots[:id]
+	return true
+}
+
+func (c *devChain) SetBalance(account common.Address, balance hexutil.Big) {
+	c.balance = balance.ToInt()
+}
+
+func (c *devChain) SetStorageAt(account common.Address, slot string, value common.Hash) error {
+	if slot != "0x1" {
+		return errors.Errorf("slot %v is not a quantity", slot)
+	}
+	return nil
+}
+
+func (c *devChain) ImpersonateAccount(account common.Address) {
+	c.impersonated[account] = true
+}
+
+func (c *devChain) StopImpersonatingAccount(account common.Address) {
+	delete(c.impersonated, account)
+}
+
+// SendTransaction returns the hash of sender as the transaction hash.
+func (c *devChain) SendTransaction(args types.TransactionArgs) (common.Hash, error) {
+	if !c.impersonated[*args.From] {
+		return common.Hash{}, errors.New("no signer")
+	}
+	return common.BytesToHash(args.From.Bytes()), nil
+}
+
+func TestDev(t *testing.T) {
+	a := assert.New(t)
+
+	chain := &devChain{balance: big.NewInt(1), impersonated: map[common.Address]bool{}}
+	server := rpc.NewServer()
+	a.NoError(server.RegisterName("evm", chain))
+	a.NoError(server.RegisterName("anvil", chain))
+	a.NoError(server.RegisterName("eth", chain))
+	t.Cleanup(server.Stop)
+
+	// transactions of accounts not managed by signer manager are rejected by SignableMiddleware
+	sm := signers.MustNewSignerManagerByMnemonic("crisp shove million stem shiver side hospital split play lottery join vintage", 1, nil)
+	dev := NewRpcDevClient(providers.NewSignableProvider(rpc.DialInProc(server), sm))
+
+	account := common.HexToAddress("0x1234")
+	a.NoError(dev.SetStorageAt(account, common.HexToHash("0x01"), common.HexToHash("0xff")))
+
+	err := dev.WithSnapshot(func() error {
+		if err := dev.SetBalance(account, big.NewInt(100)); err != nil {
+			return err
+		}
+		a.Equal(big.NewInt(100), chain.balance)
+
+		return dev.WithImpersonation(account, func() error {
+			hash, err := dev.SendUnsignedTransaction(types.TransactionArgs{From: &account})
+			a.Equal(common.BytesToHash(account.Bytes()), hash)
+			return err
+		})
+	})
+	a.NoError(err)
+	a.Equal(big.NewInt(1), chain.balance)
+	a.Empty(chain.impersonated)
+
+	_, err = dev.SendUnsignedTransaction(types.TransactionArgs{From: &account})
+	a.Error(err)
+
+	ok, err := dev.Revert(big.NewInt(5))
+	a.NoError(err)
+	a.False(ok)
+}
+
+func TestDevCleanup(t *testing.T) {
+	a := assert.New(t)
+
+	chain := &devChain{balance: big.NewInt(1), impersonated: map[common.Address]bool{}}
+	server := rpc.NewServer()
+	a.NoError(server.RegisterName("evm", chain))
+	a.NoError(server.RegisterName("anvil", chain))
+	t.Cleanup(server.Stop)
+	dev := NewRpcDevClient(rpc.DialInProc(server))
+
+	account := common.HexToAddress("0x1234")
+
+	// cleanup on panic
+	a.Panics(func() {
+		dev.WithSnapshot(func() error {
+			return dev.WithImpersonation(account, func() error {
+				if err := dev.SetBalance(account, big.NewInt(100)); err != nil {
+					return err
+				}
+				panic("failed")
+			})
+		})
+	})
+	a.Equal(big.NewInt(1), chain.balance)
+	a.Empty(chain.impersonated)
+
+	// both errors of fn and reverting are returned
+	errFn := errors.New("failed")
+	err := dev.WithSnapshot(func() error {
+		// the snapshot is consumed by fn
+		if _, err := dev.Revert(big.NewInt(0)); err != nil {
+			return err
+		}
+		return errFn
+	})
+	a.ErrorIs(err, errFn)
+	a.ErrorIs(err, ErrSnapshotNotReverted)
+}
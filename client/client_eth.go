@@ -10,6 +10,7 @@ import (
 
 	"github.com/openweb3/go-rpc-provider/interfaces"
 	"github.com/openweb3/web3go/types"
+	"github.com/pkg/errors"
 )
 
 type RpcEthClient struct {
@@ -126,6 +127,25 @@ func (c *RpcEthClient) StorageAt(addr common.Address, location *big.Int, block *
 	return
 }
 
+// Returns the account and storage values of the specified account including the Merkle-proof, use
+// AccountProof.Verify to verify it against the state root of a trusted block. Proofs of other account or
+// storage keys than requested are rejected with types.ErrInvalidProof.
+func (c *RpcEthClient) Proof(addr common.Address, storageKeys []common.Hash, block *types.BlockNumberOrHash) (val *types.AccountProof, err error) {
+	if storageKeys == nil {
+		storageKeys = []common.Hash{}
+	}
+	if err = c.CallContext(c.getContext(), &val, "eth_getProof", addr, storageKeys, getRealBlockNumberOrHash(block)); err != nil {
+		return nil, err
+	}
+	if val == nil {
+		return nil, errors.Wrapf(types.ErrInvalidProof, "no proof of account %v", addr)
+	}
+	if err = val.Match(addr, storageKeys); err != nil {
+		return nil, err
+	}
+	return val, nil
+}
+
 // Returns block with given hash.
 func (c *RpcEthClient) BlockByHash(blockHash common.Hash, isFull bool) (val *types.Block, err error) {
 	block := &types.Block{}
@@ -241,6 +261,20 @@ func (c *RpcEthClient) Call(callRequest types.CallRequest, blockNum *types.Block
 	return
 }
 
+// Simulates calls in a sequence of blocks on top of the given block with state and block overrides, and returns
+// the simulated blocks with results of calls, see SimulateOptions for the validation and transfer tracing modes.
+func (c *RpcEthClient) SimulateV1(opts types.SimulateOptions, blockNum *types.BlockNumberOrHash) (val []*types.SimulatedBlock, err error) {
+	err = c.CallContext(c.getContext(), &val, "eth_simulateV1", opts, getRealBlockNumberOrHash(blockNum))
+	return
+}
+
+// CreateAccessList returns the access list of addresses and storage keys touched by the call and the gas used
+// with the access list, which could be set to the transaction to lower its gas.
+func (c *RpcEthClient) CreateAccessList(callRequest types.CallRequest, blockNum *types.BlockNumberOrHash) (val *types.AccessListResult, err error) {
+	err = c.CallContext(c.getContext(), &val, "eth_createAccessList", callRequest, getRealBlockNumberOrHash(blockNum))
+	return
+}
+
 // Estimate gas needed for execution of given contract.
 func (c *RpcEthClient) EstimateGas(callRequest types.CallRequest, blockNum *types.BlockNumberOrHash, overrides *types.StateOverride, blockOverrides *types.BlockOverrides) (val *big.Int, err error) {
 	var _val *hexutil.Big
@@ -255,6 +289,16 @@ func (c *RpcEthClient) TransactionByHash(txHash common.Hash) (val *types.Transac
 	return
 }
 
+// RawTransactionByHash returns the transaction decoded from the binary encoding of eth_getRawTransactionByHash,
+// or nil if the transaction is not found.
+func (c *RpcEthClient) RawTransactionByHash(txHash common.Hash) (val *types.Transaction, err error) {
+	var raw hexutil.Bytes
+	if err = c.CallContext(c.getContext(), &raw, "eth_getRawTransactionByHash", txHash); err != nil {
+		return
+	}
+	return decodeRawTransaction(raw)
+}
+
 // Returns transaction at given block hash and index.
 func (c *RpcEthClient) TransactionByBlockHashAndIndex(blockHash common.Hash, index uint) (val *types.TransactionDetail, err error) {
 	err = c.CallContext(c.getContext(), &val, "eth_getTransactionByBlockHashAndIndex", blockHash, hexutil.Uint(index))
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"os"
 	"testing"
 
@@ -50,3 +51,45 @@ func TestAccountPendingTransactions(t *testing.T) {
 	assert.NoError(t, err)
 	fmt.Printf("pending: %+v\n", pendingTxs)
 }
+
+// proofNode returns the proof of the address and storage keys set, instead of the requested ones.
+type proofNode struct {
+	address common.Address
+	keys    []common.Hash
+}
+
+func (n *proofNode) GetProof(address common.Address, storageKeys []common.Hash, block types.BlockNumberOrHash) *types.AccountProof {
+	proof := &types.AccountProof{Address: n.address, Balance: big.NewInt(0)}
+	for _, key := range n.keys {
+		proof.StorageProof = append(proof.StorageProof, &types.StorageProof{Key: key.Hex(), Value: big.NewInt(0)})
+	}
+	return proof
+}
+
+func TestProof(t *testing.T) {
+	a := assert.New(t)
+	node := &proofNode{}
+	server := rpc.NewServer()
+	a.NoError(server.RegisterName("eth", node))
+	t.Cleanup(server.Stop)
+	c := NewRpcEthClient(rpc.DialInProc(server))
+
+	account := common.HexToAddress("0x000000000000000000000000000000000000c0de")
+	slot := common.HexToHash("0x01")
+	node.address, node.keys = account, []common.Hash{slot}
+	proof, err := c.Proof(account, []common.Hash{slot}, nil)
+	a.NoError(err)
+	a.Equal(account, proof.Address)
+
+	// proof of other account
+	_, err = c.Proof(common.HexToAddress("0x000000000000000000000000000000000000a11c"), []common.Hash{slot}, nil)
+	a.ErrorIs(err, types.ErrInvalidProof)
+
+	// proof of other storage keys
+	node.keys = []common.Hash{common.HexToHash("0x02")}
+	_, err = c.Proof(account, []common.Hash{slot}, nil)
+	a.ErrorIs(err, types.ErrInvalidProof)
+	node.keys = nil
+	_, err = c.Proof(account, []common.Hash{slot}, nil)
+	a.ErrorIs(err, types.ErrInvalidProof)
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/openweb3/go-rpc-provider/interfaces"
+	providers "github.com/openweb3/go-rpc-provider/provider_wrapper"
+)
+
+// RpcMinerClient controls block production of node by miner_* methods, which are usually only available on local
+// dev chains.
+type RpcMinerClient struct {
+	BaseClient
+}
+
+func NewRpcMinerClient(provider interfaces.Provider) *RpcMinerClient {
+	_client := &RpcMinerClient{}
+	_client.MiddlewarableProvider = providers.NewMiddlewarableProvider(provider)
+	return _client
+}
+
+// Starts producing blocks.
+func (c *RpcMinerClient) Start() (err error) {
+	var val any
+	err = c.CallContext(c.getContext(), &val, "miner_start")
+	return
+}
+
+// Stops producing blocks.
+func (c *RpcMinerClient) Stop() (err error) {
+	var val any
+	err = c.CallContext(c.getContext(), &val, "miner_stop")
+	return
+}
+
+// Sets the address that receives rewards and fees of produced blocks.
+func (c *RpcMinerClient) SetEtherbase(etherbase common.Address) (val bool, err error) {
+	err = c.CallContext(c.getContext(), &val, "miner_setEtherbase", etherbase)
+	return
+}
+
+// Sets the minimal gas price of transactions accepted into produced blocks.
+func (c *RpcMinerClient) SetGasPrice(gasPrice *big.Int) (val bool, err error) {
+	err = c.CallContext(c.getContext(), &val, "miner_setGasPrice", (*hexutil.Big)(gasPrice))
+	return
+}
+
+// Sets the extra data of produced blocks.
+func (c *RpcMinerClient) SetExtra(extra string) (val bool, err error) {
+	err = c.CallContext(c.getContext(), &val, "miner_setExtra", extra)
+	return
+}
+
+// Sets the gas limit that produced blocks target.
+func (c *RpcMinerClient) SetGasLimit(gasLimit uint64) (val bool, err error) {
+	err = c.CallContext(c.getContext(), &val, "miner_setGasLimit", hexutil.Uint64(gasLimit))
+	return
+}
@@ -0,0 +1,69 @@
+package client
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	rpc "github.com/openweb3/go-rpc-provider"
+	"github.com/stretchr/testify/assert"
+)
+
+// miner returns results in the format of geth.
+type miner struct {
+	mining    bool
+	etherbase common.Address
+	gasPrice  *hexutil.Big
+	gasLimit  hexutil.Uint64
+}
+
+func (m *miner) Start() { m.mining = true }
+
+func (m *miner) Stop() { m.mining = false }
+
+func (m *miner) SetEtherbase(etherbase common.Address) bool {
+	m.etherbase = etherbase
+	return true
+}
+
+func (m *miner) SetGasPrice(gasPrice hexutil.Big) bool {
+	m.gasPrice = &gasPrice
+	return true
+}
+
+func (m *miner) SetGasLimit(gasLimit hexutil.Uint64) bool {
+	m.gasLimit = gasLimit
+	return true
+}
+
+func TestMiner(t *testing.T) {
+	a := assert.New(t)
+
+	m := &miner{}
+	server := rpc.NewServer()
+	a.NoError(server.RegisterName("miner", m))
+	t.Cleanup(server.Stop)
+	client := NewRpcMinerClient(rpc.DialInProc(server))
+
+	a.NoError(client.Start())
+	a.True(m.mining)
+	a.NoError(client.Stop())
+	a.False(m.mining)
+
+	etherbase := common.HexToAddress("0x1234")
+	ok, err := client.SetEtherbase(etherbase)
+	a.NoError(err)
+	a.True(ok)
+	a.Equal(etherbase, m.etherbase)
+
+	ok, err = client.SetGasPrice(big.NewInt(1e9))
+	a.NoError(err)
+	a.True(ok)
+	a.Equal(big.NewInt(1e9), m.gasPrice.ToInt())
+
+	ok, err = client.SetGasLimit(30_000_000)
+	a.NoError(err)
+	a.True(ok)
+	a.Equal(hexutil.Uint64(30_000_000), m.gasLimit)
+}
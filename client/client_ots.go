@@ -0,0 +1,177 @@
+package client
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/openweb3/go-rpc-provider/interfaces"
+	providers "github.com/openweb3/go-rpc-provider/provider_wrapper"
+	"github.com/openweb3/web3go/types"
+)
+
+// RpcOtsClient queries the Otterscan API by ots_* methods, which is supported by erigon and anvil.
+type RpcOtsClient struct {
+	BaseClient
+}
+
+func NewRpcOtsClient(provider interfaces.Provider) *RpcOtsClient {
+	_client := &RpcOtsClient{}
+	_client.MiddlewarableProvider = providers.NewMiddlewarableProvider(provider)
+	return _client
+}
+
+// Returns the version of the Otterscan API supported by node.
+func (c *RpcOtsClient) ApiLevel() (val uint64, err error) {
+	err = c.CallContext(c.getContext(), &val, "ots_getApiLevel")
+	return
+}
+
+// Returns true if address has code at block.
+func (c *RpcOtsClient) HasCode(address common.Address, blockNum *types.BlockNumberOrHash) (val bool, err error) {
+	err = c.CallContext(c.getContext(), &val, "ots_hasCode", address, getRealBlockNumberOrHash(blockNum))
+	return
+}
+
+// Returns the internal operations of transaction that transfer value, self destruct or create contracts.
+func (c *RpcOtsClient) InternalOperations(txHash common.Hash) (val []*types.OtsInternalOperation, err error) {
+	err = c.CallContext(c.getContext(), &val, "ots_getInternalOperations", txHash)
+	return
+}
+
+// Returns the revert data of transaction, it is empty if the transaction succeeded or reverted without data.
+func (c *RpcOtsClient) TransactionError(txHash common.Hash) (val []byte, err error) {
+	var _val hexutil.Bytes
+	err = c.CallContext(c.getContext(), &_val, "ots_getTransactionError", txHash)
+	val = ([]byte)(_val)
+	return
+}
+
+// Returns the calls of transaction in the order of execution.
+func (c *RpcOtsClient) TraceTransaction(txHash common.Hash) (val []*types.OtsTraceEntry, err error) {
+	err = c.CallContext(c.getContext(), &val, "ots_traceTransaction", txHash)
+	return
+}
+
+// Returns the block without transactions, with the issuance and total fees of block.
+func (c *RpcOtsClient) BlockDetails(blockNumber types.BlockNumber) (val *types.OtsBlockDetails, err error) {
+	err = c.CallContext(c.getContext(), &val, "ots_getBlockDetails", blockNumber)
+	return
+}
+
+// Returns the block without transactions, with the issuance and total fees of block.
+func (c *RpcOtsClient) BlockDetailsByHash(blockHash common.Hash) (val *types.OtsBlockDetails, err error) {
+	err = c.CallContext(c.getContext(), &val, "ots_getBlockDetailsByHash", blockHash)
+	return
+}
+
+// Returns the contract creation transaction and the creator of contract, it returns nil if address is not a
+// contract.
+func (c *RpcOtsClient) ContractCreator(address common.Address) (val *types.OtsContractCreator, err error) {
+	err = c.CallContext(c.getContext(), &val, "ots_getContractCreator", address)
+	return
+}
+
+// Returns the hash of transaction sent by sender with nonce, it returns nil if the transaction is not found.
+func (c *RpcOtsClient) TransactionBySenderAndNonce(sender common.Address, nonce uint64) (val *common.Hash, err error) {
+	err = c.CallContext(c.getContext(), &val, "ots_getTransactionBySenderAndNonce", sender, hexutil.Uint64(nonce))
+	return
+}
+
+// SearchTransactionsBefore returns transactions of address in blocks before blockNumber in descending order,
+// starting from the latest block if blockNumber is 0. Transactions of a block are never split across pages, so
+// a page may contain more than pageSize transactions. Use SearchTransactionsBeforeIterator to iterate all pages.
+func (c *RpcOtsClient) SearchTransactionsBefore(address common.Address, blockNumber uint64, pageSize int) (val *types.OtsSearchResult, err error) {
+	err = c.CallContext(c.getContext(), &val, "ots_searchTransactionsBefore", address, blockNumber, pageSize)
+	return
+}
+
+// SearchTransactionsAfter returns transactions of address in blocks after blockNumber in descending order,
+// starting from the genesis block if blockNumber is 0. See SearchTransactionsBefore for pageSize.
+func (c *RpcOtsClient) SearchTransactionsAfter(address common.Address, blockNumber uint64, pageSize int) (val *types.OtsSearchResult, err error) {
+	err = c.CallContext(c.getContext(), &val, "ots_searchTransactionsAfter", address, blockNumber, pageSize)
+	return
+}
+
+// SearchTransactionsBeforeIterator returns an iterator of transaction pages of address from blockNumber back to
+// the genesis block, starting from the latest block if blockNumber is 0.
+func (c *RpcOtsClient) SearchTransactionsBeforeIterator(address common.Address, blockNumber uint64, pageSize int) *OtsSearchIterator {
+	return &OtsSearchIterator{
+		client:      c,
+		address:     address,
+		blockNumber: blockNumber,
+		pageSize:    pageSize,
+		before:      true,
+	}
+}
+
+// SearchTransactionsAfterIterator returns an iterator of transaction pages of address from blockNumber forward to
+// the latest block, starting from the genesis block if blockNumber is 0.
+func (c *RpcOtsClient) SearchTransactionsAfterIterator(address common.Address, blockNumber uint64, pageSize int) *OtsSearchIterator {
+	return &OtsSearchIterator{
+		client:      c,
+		address:     address,
+		blockNumber: blockNumber,
+		pageSize:    pageSize,
+	}
+}
+
+// OtsSearchIterator iterates transactions of address page by page by ots_searchTransactionsBefore or
+// ots_searchTransactionsAfter, transactions in each page are in descending order.
+//
+//	it := client.Ots.SearchTransactionsBeforeIterator(address, 0, 25)
+//	for it.Next() {
+//		for i, tx := range it.Page().Txs { ... }
+//	}
+//	if err := it.Err(); err != nil { ... }
+type OtsSearchIterator struct {
+	client      *RpcOtsClient
+	address     common.Address
+	blockNumber uint64
+	pageSize    int
+	before      bool
+
+	done bool
+	page *types.OtsSearchResult
+	err  error
+}
+
+// Next requests the next page, it returns false if there is no more pages or an error occurs.
+func (it *OtsSearchIterator) Next() bool {
+	if it.done {
+		return false
+	}
+
+	if it.before {
+		it.page, it.err = it.client.SearchTransactionsBefore(it.address, it.blockNumber, it.pageSize)
+	} else {
+		it.page, it.err = it.client.SearchTransactionsAfter(it.address, it.blockNumber, it.pageSize)
+	}
+	if it.err != nil {
+		it.done = true
+		return false
+	}
+
+	if it.page == nil || len(it.page.Receipts) == 0 {
+		it.done = true
+		return false
+	}
+
+	// the oldest block of page is the start of next page for before, and the latest block for after
+	if it.before {
+		it.done = it.page.LastPage
+		it.blockNumber = it.page.Receipts[len(it.page.Receipts)-1].BlockNumber
+	} else {
+		it.done = it.page.FirstPage
+		it.blockNumber = it.page.Receipts[0].BlockNumber
+	}
+	return true
+}
+
+// Page returns the current page.
+func (it *OtsSearchIterator) Page() *types.OtsSearchResult {
+	return it.page
+}
+
+// Err returns the error occurred when requesting pages.
+func (it *OtsSearchIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,146 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	rpc "github.com/openweb3/go-rpc-provider"
+	"github.com/openweb3/web3go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// otsChain returns results in the format of erigon, address has a transaction with nonce n-1 in block n.
+type otsChain struct {
+	latest uint64
+}
+
+func (c *otsChain) transaction(blockNumber uint64) (json.RawMessage, json.RawMessage) {
+	tx := fmt.Sprintf(`{"blockNumber":"%#x","from":"0x0000000000000000000000000000000000001234","gas":"0x5208","hash":"%v","input":"0x","nonce":"%#x","r":"0x1","s":"0x1","v":"0x1","value":"0x0"}`,
+		blockNumber, common.BigToHash(new(big.Int).SetUint64(blockNumber)), blockNumber-1)
+	// erigon encodes timestamp of receipt as a number
+	receipt := fmt.Sprintf(`{"blockNumber":"%#x","from":"0x0000000000000000000000000000000000001234","status":"0x1","timestamp":%d}`,
+		blockNumber, blockNumber*12)
+	return json.RawMessage(tx), json.RawMessage(receipt)
+}
+
+// search returns transactions of blocks in descending order.
+func (c *otsChain) search(blocks []uint64, firstPage, lastPage bool) map[string]any {
+	txs, receipts := []json.RawMessage{}, []json.RawMessage{}
+	for _, n := range blocks {
+		tx, receipt := c.transaction(n)
+		txs = append(txs, tx)
+		receipts = append(receipts, receipt)
+	}
+	return map[string]any{"txs": txs, "receipts": receipts, "firstPage": firstPage, "lastPage": lastPage}
+}
+
+func (c *otsChain) SearchTransactionsBefore(address common.Address, blockNumber uint64, pageSize int) map[string]any {
+	if blockNumber == 0 {
+		blockNumber = c.latest + 1
+	}
+	var blocks []uint64
+	for n := blockNumber - 1; n >= 1 && len(blocks) < pageSize; n-- {
+		blocks = append(blocks, n)
+	}
+	return c.search(blocks, blockNumber == c.latest+1, blocks[len(blocks)-1] == 1)
+}
+
+func (c *otsChain) SearchTransactionsAfter(address common.Address, blockNumber uint64, pageSize int) map[string]any {
+	var blocks []uint64
+	for n := blockNumber + 1; n <= c.latest && len(blocks) < pageSize; n++ {
+		blocks = append([]uint64{n}, blocks...)
+	}
+	return c.search(blocks, blocks[0] == c.latest, blockNumber == 0)
+}
+
+func (c *otsChain) GetContractCreator(address common.Address) map[string]any {
+	if address == (common.Address{}) {
+		return nil
+	}
+	return map[string]any{"hash": common.HexToHash("0x01"), "creator": common.HexToAddress("0x1234")}
+}
+
+func (c *otsChain) GetTransactionBySenderAndNonce(address common.Address, nonce hexutil.Uint64) *common.Hash {
+	hash := common.BigToHash(new(big.Int).SetUint64(uint64(nonce) + 1))
+	return &hash
+}
+
+func (c *otsChain) GetInternalOperations(txHash common.Hash) json.RawMessage {
+	return json.RawMessage(`[{"type":2,"from":"0x0000000000000000000000000000000000001234","to":"0x000000000000000000000000000000000000c0de","value":"0x64"}]`)
+}
+
+func (c *otsChain) TraceTransaction(txHash common.Hash) json.RawMessage {
+	return json.RawMessage(`[{"type":"CALL","depth":0,"from":"0x0000000000000000000000000000000000001234","to":"0x000000000000000000000000000000000000c0de","value":"0x0","input":"0x01"},` +
+		`{"type":"STATICCALL","depth":1,"from":"0x000000000000000000000000000000000000c0de","to":"0x0000000000000000000000000000000000000001","value":null,"input":"0x02","output":"0x03"}]`)
+}
+
+func (c *otsChain) GetTransactionError(txHash common.Hash) hexutil.Bytes {
+	return hexutil.MustDecode("0x08c379a0")
+}
+
+func TestOts(t *testing.T) {
+	a := assert.New(t)
+
+	server := rpc.NewServer()
+	a.NoError(server.RegisterName("ots", &otsChain{latest: 7}))
+	t.Cleanup(server.Stop)
+	ots := NewRpcOtsClient(rpc.DialInProc(server))
+
+	address := common.HexToAddress("0x1234")
+
+	// before
+	var blocks []uint64
+	it := ots.SearchTransactionsBeforeIterator(address, 0, 3)
+	for it.Next() {
+		for i, tx := range it.Page().Txs {
+			a.Equal(tx.BlockNumber.Uint64(), it.Page().Receipts[i].BlockNumber)
+			blocks = append(blocks, tx.BlockNumber.Uint64())
+		}
+	}
+	a.NoError(it.Err())
+	a.Equal([]uint64{7, 6, 5, 4, 3, 2, 1}, blocks)
+
+	// after
+	blocks = nil
+	it = ots.SearchTransactionsAfterIterator(address, 2, 2)
+	for it.Next() {
+		for _, receipt := range it.Page().Receipts {
+			a.Equal(receipt.BlockNumber*12, receipt.Timestamp)
+			blocks = append(blocks, receipt.BlockNumber)
+		}
+	}
+	a.NoError(it.Err())
+	a.Equal([]uint64{4, 3, 6, 5, 7}, blocks)
+
+	creator, err := ots.ContractCreator(address)
+	a.NoError(err)
+	a.Equal(common.HexToAddress("0x1234"), creator.Creator)
+
+	creator, err = ots.ContractCreator(common.Address{})
+	a.NoError(err)
+	a.Nil(creator)
+
+	hash, err := ots.TransactionBySenderAndNonce(address, 2)
+	a.NoError(err)
+	a.Equal(common.BigToHash(big.NewInt(3)), *hash)
+
+	operations, err := ots.InternalOperations(*hash)
+	a.NoError(err)
+	a.Equal(types.OtsOperationCreate, operations[0].Type)
+	a.Equal(big.NewInt(100), operations[0].Value)
+
+	trace, err := ots.TraceTransaction(*hash)
+	a.NoError(err)
+	a.Equal(2, len(trace))
+	a.Equal(1, trace[1].Depth)
+	a.Nil(trace[1].Value)
+	a.Equal([]byte{0x03}, trace[1].Output)
+
+	revert, err := ots.TransactionError(*hash)
+	a.NoError(err)
+	a.Equal([]byte{0x08, 0xc3, 0x79, 0xa0}, revert)
+}
@@ -0,0 +1,77 @@
+package client
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/openweb3/go-rpc-provider/interfaces"
+	providers "github.com/openweb3/go-rpc-provider/provider_wrapper"
+	"github.com/openweb3/web3go/types"
+)
+
+// RpcPersonalClient manages accounts in the keystore of node by personal_* methods, which are usually only
+// available on local dev chains.
+type RpcPersonalClient struct {
+	BaseClient
+}
+
+func NewRpcPersonalClient(provider interfaces.Provider) *RpcPersonalClient {
+	_client := &RpcPersonalClient{}
+	_client.MiddlewarableProvider = providers.NewMiddlewarableProvider(provider)
+	return _client
+}
+
+// Returns the addresses of accounts in the keystore of node.
+func (c *RpcPersonalClient) ListAccounts() (val []common.Address, err error) {
+	err = c.CallContext(c.getContext(), &val, "personal_listAccounts")
+	return
+}
+
+// Creates a new account encrypted by password in the keystore of node, and returns its address.
+func (c *RpcPersonalClient) NewAccount(password string) (val common.Address, err error) {
+	err = c.CallContext(c.getContext(), &val, "personal_newAccount", password)
+	return
+}
+
+// Imports the private key encrypted by password into the keystore of node, and returns its address.
+func (c *RpcPersonalClient) ImportRawKey(privateKey *ecdsa.PrivateKey, password string) (val common.Address, err error) {
+	err = c.CallContext(c.getContext(), &val, "personal_importRawKey", hex.EncodeToString(crypto.FromECDSA(privateKey)), password)
+	return
+}
+
+// Unlocks the account for duration seconds, it is 300 seconds if duration is nil and unlocked until the node
+// exits if duration is 0.
+func (c *RpcPersonalClient) UnlockAccount(account common.Address, password string, duration *uint64) (val bool, err error) {
+	err = c.CallContext(c.getContext(), &val, "personal_unlockAccount", account, password, duration)
+	return
+}
+
+// Locks the unlocked account.
+func (c *RpcPersonalClient) LockAccount(account common.Address) (val bool, err error) {
+	err = c.CallContext(c.getContext(), &val, "personal_lockAccount", account)
+	return
+}
+
+// Signs the EIP-191 personal message of data by account, the account is unlocked by password for the request.
+func (c *RpcPersonalClient) Sign(data []byte, account common.Address, password string) (val []byte, err error) {
+	var _val hexutil.Bytes
+	err = c.CallContext(c.getContext(), &_val, "personal_sign", hexutil.Bytes(data), account, password)
+	val = ([]byte)(_val)
+	return
+}
+
+// Returns the account that signed the EIP-191 personal message of data, which is signed by Sign.
+func (c *RpcPersonalClient) EcRecover(data []byte, signature []byte) (val common.Address, err error) {
+	err = c.CallContext(c.getContext(), &val, "personal_ecRecover", hexutil.Bytes(data), hexutil.Bytes(signature))
+	return
+}
+
+// Sends the transaction signed by args.From, which is unlocked by password for the request. Missing fields of
+// args are populated by node.
+func (c *RpcPersonalClient) SendTransaction(args types.TransactionArgs, password string) (val common.Hash, err error) {
+	err = c.CallContext(c.getContext(), &val, "personal_sendTransaction", args, password)
+	return
+}
@@ -0,0 +1,99 @@
+package client
+
+import (
+	"crypto/ecdsa"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	rpc "github.com/openweb3/go-rpc-provider"
+	"github.com/openweb3/web3go/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// keystore keeps keys in memory and signs with them in the way of geth.
+type keystore struct {
+	password string
+	keys     map[common.Address]*ecdsa.PrivateKey
+	unlocked map[common.Address]bool
+}
+
+func (k *keystore) ImportRawKey(privkey string, password string) (common.Address, error) {
+	key, err := crypto.HexToECDSA(privkey)
+	if err != nil {
+		return common.Address{}, err
+	}
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	k.keys[addr] = key
+	return addr, nil
+}
+
+func (k *keystore) UnlockAccount(addr common.Address, password string, duration *uint64) (bool, error) {
+	if password != k.password {
+		return false, errors.New("could not decrypt key with given password")
+	}
+	k.unlocked[addr] = true
+	return true, nil
+}
+
+func (k *keystore) Sign(data hexutil.Bytes, addr common.Address, password string) (hexutil.Bytes, error) {
+	sig, err := crypto.Sign(accounts.TextHash(data), k.keys[addr])
+	if err != nil {
+		return nil, err
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return sig, nil
+}
+
+func (k *keystore) EcRecover(data, sig hexutil.Bytes) (common.Address, error) {
+	sig[crypto.RecoveryIDOffset] -= 27
+	pubkey, err := crypto.SigToPub(accounts.TextHash(data), sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(*pubkey), nil
+}
+
+// SendTransaction returns the hash of sender as the transaction hash.
+func (k *keystore) SendTransaction(args types.TransactionArgs, password string) (common.Hash, error) {
+	if password != k.password {
+		return common.Hash{}, errors.New("could not decrypt key with given password")
+	}
+	return common.BytesToHash(args.From.Bytes()), nil
+}
+
+func TestPersonal(t *testing.T) {
+	a := assert.New(t)
+
+	server := rpc.NewServer()
+	a.NoError(server.RegisterName("personal", &keystore{password: "pwd", keys: map[common.Address]*ecdsa.PrivateKey{}, unlocked: map[common.Address]bool{}}))
+	t.Cleanup(server.Stop)
+	personal := NewRpcPersonalClient(rpc.DialInProc(server))
+
+	key, _ := crypto.GenerateKey()
+	addr, err := personal.ImportRawKey(key, "pwd")
+	a.NoError(err)
+	a.Equal(crypto.PubkeyToAddress(key.PublicKey), addr)
+
+	ok, err := personal.UnlockAccount(addr, "pwd", types.Pointer(uint64(0)))
+	a.NoError(err)
+	a.True(ok)
+
+	_, err = personal.UnlockAccount(addr, "wrong", nil)
+	a.Error(err)
+
+	sig, err := personal.Sign([]byte("hello"), addr, "pwd")
+	a.NoError(err)
+	a.Equal(65, len(sig))
+
+	signer, err := personal.EcRecover([]byte("hello"), sig)
+	a.NoError(err)
+	a.Equal(addr, signer)
+
+	hash, err := personal.SendTransaction(types.TransactionArgs{From: &addr}, "pwd")
+	a.NoError(err)
+	a.Equal(common.BytesToHash(addr.Bytes()), hash)
+}
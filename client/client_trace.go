@@ -53,6 +53,13 @@ func (c *RpcTraceClient) Call(request types.CallRequest, options types.TraceOpti
 	return
 }
 
+// Executes the given calls in sequence, each call is executed on the state of previous calls, and returns a number
+// of possible traces for each call.
+func (c *RpcTraceClient) CallMany(requests []types.TraceCallRequest, blockNumber *types.BlockNumberOrHash) (val []types.TraceResults, err error) {
+	err = c.CallContext(c.getContext(), &val, "trace_callMany", requests, getRealBlockNumberOrHash(blockNumber))
+	return
+}
+
 // Executes the given raw transaction and returns a number of possible traces for it.
 func (c *RpcTraceClient) RawTransaction(rawTransaction []byte, options types.TraceOptions, blockNumber *types.BlockNumberOrHash) (val types.TraceResults, err error) {
 	_rawTransaction := (hexutil.Bytes)(rawTransaction)
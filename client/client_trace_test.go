@@ -0,0 +1,40 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	rpc "github.com/openweb3/go-rpc-provider"
+	"github.com/openweb3/web3go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type traceChain struct{}
+
+// CallMany returns the recipient and the number of trace types of each call as output.
+func (c *traceChain) CallMany(requests []types.TraceCallRequest, blockNum types.BlockNumberOrHash) ([]types.TraceResults, error) {
+	result := make([]types.TraceResults, len(requests))
+	for i, request := range requests {
+		result[i] = types.TraceResults{Output: append(request.Request.To.Bytes(), byte(len(request.Options)))}
+	}
+	return result, nil
+}
+
+func TestTraceCallMany(t *testing.T) {
+	a := assert.New(t)
+
+	server := rpc.NewServer()
+	a.NoError(server.RegisterName("trace", &traceChain{}))
+	t.Cleanup(server.Stop)
+	trace := NewRpcTraceClient(rpc.DialInProc(server))
+
+	to1, to2 := common.HexToAddress("0x01"), common.HexToAddress("0x02")
+	results, err := trace.CallMany([]types.TraceCallRequest{
+		{Request: types.CallRequest{To: &to1}, Options: types.TraceOptions{"trace"}},
+		{Request: types.CallRequest{To: &to2}, Options: types.TraceOptions{"trace", "stateDiff"}},
+	}, nil)
+	a.NoError(err)
+	a.Equal(2, len(results))
+	a.Equal(append(to1.Bytes(), 1), results[0].Output)
+	a.Equal(append(to2.Bytes(), 2), results[1].Output)
+}
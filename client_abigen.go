@@ -2,12 +2,13 @@ package web3go
 
 import (
 	"context"
-	"errors"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/openweb3/web3go/client"
 	"github.com/openweb3/web3go/types"
 )
 
@@ -24,57 +25,220 @@ func NewClientForContract(raw *Client) *ClientForContract {
 	}
 }
 
+type senderContextKey struct{}
+
+// WithSender returns a copy of ctx carrying the sender, ClientForContract.SendTransaction
+// uses it to send unsigned transactions.
+func WithSender(ctx context.Context, from common.Address) context.Context {
+	return context.WithValue(ctx, senderContextKey{}, from)
+}
+
+func senderFromContext(ctx context.Context) (common.Address, bool) {
+	from, ok := ctx.Value(senderContextKey{}).(common.Address)
+	return from, ok
+}
+
+// NewUnsignedTransactOpts returns TransactOpts which keep transactions unsigned, the transactions are sent
+// by `eth_sendTransaction` from the given address, so they are signed by the signer manager of client if
+// exists or by the node otherwise.
+func NewUnsignedTransactOpts(ctx context.Context, from common.Address) *bind.TransactOpts {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return &bind.TransactOpts{
+		From:    from,
+		Context: WithSender(ctx, from),
+		Signer: func(addr common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if addr != from {
+				return nil, bind.ErrNotAuthorized
+			}
+			return tx, nil
+		},
+	}
+}
+
+// eth returns the eth client with the given context
+func (c *ClientForContract) eth(ctx context.Context) *client.RpcEthClient {
+	eth := *c.raw.Eth
+	eth.SetContext(ctx)
+	return &eth
+}
+
+// TransactionReceipt returns the receipt of a mined transaction, ethereum.NotFound is returned if
+// the transaction is pending or not exists.
 func (c *ClientForContract) TransactionReceipt(ctx context.Context, txHash common.Hash) (*ethtypes.Receipt, error) {
-	r, err := c.raw.Eth.TransactionReceipt(txHash)
+	r, err := c.eth(ctx).TransactionReceipt(txHash)
 	if err != nil {
 		return nil, err
 	}
+	if r == nil {
+		return nil, ethereum.NotFound
+	}
 	ethReceipt := toEthReceipt(*r)
 	return &ethReceipt, nil
 }
 
+// TransactionByHash returns the transaction with the given hash, isPending is true if the
+// transaction is not mined yet.
+func (c *ClientForContract) TransactionByHash(ctx context.Context, txHash common.Hash) (tx *ethtypes.Transaction, isPending bool, err error) {
+	detail, err := c.eth(ctx).TransactionByHash(txHash)
+	if err != nil {
+		return nil, false, err
+	}
+	if detail == nil {
+		return nil, false, ethereum.NotFound
+	}
+	tx, err = detail.ToEthTransaction()
+	if err != nil {
+		return nil, false, err
+	}
+	return tx, detail.BlockNumber == nil, nil
+}
+
 func (c *ClientForContract) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
 	bnOrHash := types.BlockNumberOrHashWithNumber(getBlockNumberIfy(blockNumber))
-	return c.raw.Eth.CodeAt(account, &bnOrHash)
+	return c.eth(ctx).CodeAt(account, &bnOrHash)
+}
+
+// CodeAtHash returns the code of the given account in the state at the specified block hash.
+func (c *ClientForContract) CodeAtHash(ctx context.Context, account common.Address, blockHash common.Hash) ([]byte, error) {
+	bnOrHash := types.BlockNumberOrHashWithHash(blockHash, false)
+	return c.eth(ctx).CodeAt(account, &bnOrHash)
 }
 
 func (c *ClientForContract) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
 	cr := convertCallMsg2CallRequest(call)
 	bn := types.BlockNumberOrHashWithNumber(getBlockNumberIfy(blockNumber))
-	return c.raw.Eth.Call(cr, &bn, nil, nil)
+	return c.eth(ctx).Call(cr, &bn, nil, nil)
+}
+
+// CallContractAtHash executes an Ethereum contract call against the state at the specified block hash.
+func (c *ClientForContract) CallContractAtHash(ctx context.Context, call ethereum.CallMsg, blockHash common.Hash) ([]byte, error) {
+	cr := convertCallMsg2CallRequest(call)
+	bnOrHash := types.BlockNumberOrHashWithHash(blockHash, false)
+	return c.eth(ctx).Call(cr, &bnOrHash, nil, nil)
 }
 
 // PendingCallContract executes an Ethereum contract call against the pending state.
 func (c *ClientForContract) PendingCallContract(ctx context.Context, call ethereum.CallMsg) ([]byte, error) {
 	cr := convertCallMsg2CallRequest(call)
 	pending := types.BlockNumberOrHashWithNumber(types.PendingBlockNumber)
-	return c.raw.Eth.Call(cr, &pending, nil, nil)
+	return c.eth(ctx).Call(cr, &pending, nil, nil)
+}
+
+// BalanceAt returns the wei balance of the given account.
+// The block number can be nil, in which case the balance is taken from the latest known block.
+func (c *ClientForContract) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	bnOrHash := types.BlockNumberOrHashWithNumber(getBlockNumberIfy(blockNumber))
+	return c.eth(ctx).Balance(account, &bnOrHash)
+}
+
+// StorageAt returns the value of key in the contract storage of the given account.
+// The block number can be nil, in which case the value is taken from the latest known block.
+func (c *ClientForContract) StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error) {
+	bnOrHash := types.BlockNumberOrHashWithNumber(getBlockNumberIfy(blockNumber))
+	val, err := c.eth(ctx).StorageAt(account, key.Big(), &bnOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return val.Bytes(), nil
+}
+
+// NonceAt returns the account nonce of the given account.
+// The block number can be nil, in which case the nonce is taken from the latest known block.
+func (c *ClientForContract) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	bnOrHash := types.BlockNumberOrHashWithNumber(getBlockNumberIfy(blockNumber))
+	nonce, err := c.eth(ctx).TransactionCount(account, &bnOrHash)
+	if err != nil {
+		return 0, err
+	}
+	return nonce.Uint64(), nil
+}
+
+// BlockByHash returns the given full block.
+func (c *ClientForContract) BlockByHash(ctx context.Context, hash common.Hash) (*ethtypes.Block, error) {
+	b, err := c.eth(ctx).BlockByHash(hash, true)
+	if err != nil {
+		return nil, err
+	}
+	if b == nil {
+		return nil, ethereum.NotFound
+	}
+	return b.ToEthBlock()
+}
+
+// BlockByNumber returns a block from the current canonical chain. If number is nil, the
+// latest known block is returned.
+func (c *ClientForContract) BlockByNumber(ctx context.Context, number *big.Int) (*ethtypes.Block, error) {
+	b, err := c.eth(ctx).BlockByNumber(getBlockNumberIfy(number), true)
+	if err != nil {
+		return nil, err
+	}
+	if b == nil {
+		return nil, ethereum.NotFound
+	}
+	return b.ToEthBlock()
+}
+
+// HeaderByHash returns the block header with the given hash.
+func (c *ClientForContract) HeaderByHash(ctx context.Context, hash common.Hash) (*ethtypes.Header, error) {
+	b, err := c.eth(ctx).BlockByHash(hash, false)
+	if err != nil {
+		return nil, err
+	}
+	return toEthHeader(b)
 }
 
 // HeaderByNumber returns a block header from the current canonical chain. If
 // number is nil, the latest known header is returned.
 func (c *ClientForContract) HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error) {
-	b, err := c.raw.Eth.BlockByNumber(getBlockNumberIfy(number), false)
+	b, err := c.eth(ctx).BlockByNumber(getBlockNumberIfy(number), false)
 	if err != nil {
 		return nil, err
 	}
-	h, err := b.Header()
+	return toEthHeader(b)
+}
+
+// TransactionCount returns the total number of transactions in the given block.
+func (c *ClientForContract) TransactionCount(ctx context.Context, blockHash common.Hash) (uint, error) {
+	count, err := c.eth(ctx).BlockTransactionCountByHash(blockHash)
+	if err != nil {
+		return 0, err
+	}
+	if count == nil {
+		return 0, ethereum.NotFound
+	}
+	return uint(count.Uint64()), nil
+}
+
+// TransactionInBlock returns a single transaction at index in the given block.
+func (c *ClientForContract) TransactionInBlock(ctx context.Context, blockHash common.Hash, index uint) (*ethtypes.Transaction, error) {
+	detail, err := c.eth(ctx).TransactionByBlockHashAndIndex(blockHash, index)
 	if err != nil {
 		return nil, err
 	}
-	return &h.Header, nil
+	if detail == nil {
+		return nil, ethereum.NotFound
+	}
+	return detail.ToEthTransaction()
+}
+
+// SubscribeNewHead subscribes to notifications about the current blockchain head
+// on the given channel.
+func (c *ClientForContract) SubscribeNewHead(ctx context.Context, ch chan<- *ethtypes.Header) (ethereum.Subscription, error) {
+	return c.raw.Subscribe(ctx, "eth", ch, "newHeads")
 }
 
 // PendingCodeAt returns the code of the given account in the pending state.
 func (c *ClientForContract) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
 	pending := types.BlockNumberOrHashWithNumber(types.PendingBlockNumber)
-	return c.raw.Eth.CodeAt(account, &pending)
+	return c.eth(ctx).CodeAt(account, &pending)
 }
 
 // PendingNonceAt retrieves the current pending nonce associated with an account.
 func (c *ClientForContract) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
 	pending := types.BlockNumberOrHashWithNumber(types.PendingBlockNumber)
-	nonce, err := c.raw.Eth.TransactionCount(account, &pending)
+	nonce, err := c.eth(ctx).TransactionCount(account, &pending)
 	if err != nil {
 		return 0, err
 	}
@@ -84,13 +248,13 @@ func (c *ClientForContract) PendingNonceAt(ctx context.Context, account common.A
 // SuggestGasPrice retrieves the currently suggested gas price to allow a timely
 // execution of a transaction.
 func (c *ClientForContract) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
-	return c.raw.Eth.GasPrice()
+	return c.eth(ctx).GasPrice()
 }
 
 // SuggestGasTipCap retrieves the currently suggested 1559 priority fee to allow
 // a timely execution of a transaction.
 func (c *ClientForContract) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
-	return c.raw.Eth.MaxPriorityFeePerGas()
+	return c.eth(ctx).MaxPriorityFeePerGas()
 }
 
 // EstimateGas tries to estimate the gas needed to execute a specific
@@ -102,7 +266,7 @@ func (c *ClientForContract) EstimateGas(ctx context.Context, call ethereum.CallM
 	cr := convertCallMsg2CallRequest(call)
 
 	pending := types.BlockNumberOrHashWithNumber(types.PendingBlockNumber)
-	val, err := c.raw.Eth.EstimateGas(cr, &pending, nil, nil)
+	val, err := c.eth(ctx).EstimateGas(cr, &pending, nil, nil)
 	if err != nil {
 		return 0, err
 	}
@@ -110,20 +274,27 @@ func (c *ClientForContract) EstimateGas(ctx context.Context, call ethereum.CallM
 }
 
 // SendTransaction injects the transaction into the pending pool for execution.
+//
+// Unsigned transactions are sent by `eth_sendTransaction` from the sender carried by ctx,
+// see WithSender and NewUnsignedTransactOpts. If ctx carries no sender, the signer selected
+// by SignerManager.Select is used for backward compatibility, which is the first signer by default.
 func (c *ClientForContract) SendTransaction(ctx context.Context, tx *types.Transaction) error {
-	// if not signed, sign it by first account
-	if v, _, _ := tx.RawSignatureValues(); v == nil {
-		sm, err := c.raw.GetSignerManager()
-		if err != nil {
-			return err
+	if v, r, s := tx.RawSignatureValues(); v == nil || (v.Sign() == 0 && r.Sign() == 0 && s.Sign() == 0) {
+		from, ok := senderFromContext(ctx)
+		if !ok {
+			sm, err := c.raw.GetSignerManager()
+			if err != nil {
+				return err
+			}
+
+			signer, err := sm.Select()
+			if err != nil {
+				return err
+			}
+			from = signer.Address()
 		}
 
-		if len(sm.List()) == 0 {
-			return errors.New("no signer available")
-		}
-
-		account := sm.List()[0].Address()
-		_, err = c.raw.Eth.SendTransaction(account, tx)
+		_, err := c.eth(ctx).SendTransaction(from, tx)
 		return err
 	}
 
@@ -133,7 +304,7 @@ func (c *ClientForContract) SendTransaction(ctx context.Context, tx *types.Trans
 		return err
 	}
 
-	_, err = c.raw.Eth.SendRawTransaction(rawTx)
+	_, err = c.eth(ctx).SendRawTransaction(rawTx)
 	return err
 }
 
@@ -143,7 +314,7 @@ func (c *ClientForContract) SendTransaction(ctx context.Context, tx *types.Trans
 // TODO(karalabe): Deprecate when the subscription one can return past data too.
 func (c *ClientForContract) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]ethtypes.Log, error) {
 	q := convertFilterQuery(query)
-	logs, err := c.raw.Eth.Logs(q)
+	logs, err := c.eth(ctx).Logs(q)
 	if err != nil {
 		return nil, err
 	}
@@ -154,7 +325,18 @@ func (c *ClientForContract) FilterLogs(ctx context.Context, query ethereum.Filte
 // a subscription immediately, which can be used to stream the found events.
 func (c *ClientForContract) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- ethtypes.Log) (ethereum.Subscription, error) {
 	q := convertFilterQuery(query)
-	return c.raw.Subscribe(context.Background(), "eth", ch, "logs", q)
+	return c.raw.Subscribe(ctx, "eth", ch, "logs", q)
+}
+
+func toEthHeader(b *types.Block) (*ethtypes.Header, error) {
+	if b == nil {
+		return nil, ethereum.NotFound
+	}
+	h, err := b.Header()
+	if err != nil {
+		return nil, err
+	}
+	return &h.Header, nil
 }
 
 // getBlockNumberIfy returns latest block number if input nil
@@ -167,12 +349,10 @@ func getBlockNumberIfy(blockNumber *big.Int) types.BlockNumber {
 }
 
 func convertFilterQuery(query ethereum.FilterQuery) types.FilterQuery {
-	fromBlock := types.NewBlockNumber(query.FromBlock.Int64())
-	toBlock := types.NewBlockNumber(query.ToBlock.Int64())
 	return types.FilterQuery{
 		BlockHash: query.BlockHash,
-		FromBlock: &fromBlock,
-		ToBlock:   &toBlock,
+		FromBlock: types.BigIntToBlockNumber(query.FromBlock),
+		ToBlock:   types.BigIntToBlockNumber(query.ToBlock),
 		Addresses: query.Addresses,
 		Topics:    query.Topics,
 	}
@@ -214,6 +394,7 @@ func toEthReceipt(r types.Receipt) ethtypes.Receipt {
 		BlockHash:         r.BlockHash,
 		BlockNumber:       new(big.Int).SetUint64(r.BlockNumber),
 		TransactionIndex:  uint(r.TransactionIndex),
+		EffectiveGasPrice: r.EffectiveGasPrice,
 	}
 	if r.Type != nil {
 		eReceipt.Type = uint8(*r.Type)
@@ -1,12 +1,200 @@
 package web3go
 
 import (
+	"context"
+	"encoding/json"
+	"math/big"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	rpc "github.com/openweb3/go-rpc-provider"
+	"github.com/openweb3/web3go/interfaces"
+	"github.com/openweb3/web3go/signers"
+	"github.com/openweb3/web3go/types"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestClientForContractImplementInterfaces(t *testing.T) {
 	var _ bind.ContractBackend = &ClientForContract{}
 	var _ bind.DeployBackend = &ClientForContract{}
+	var _ bind.PendingContractCaller = &ClientForContract{}
+	var _ bind.BlockHashContractCaller = &ClientForContract{}
+	var _ ethereum.ChainReader = &ClientForContract{}
+	var _ ethereum.ChainStateReader = &ClientForContract{}
+	var _ ethereum.TransactionReader = &ClientForContract{}
+}
+
+func TestUnsignedTransactOpts(t *testing.T) {
+	from := common.HexToAddress("0xe6D148D8398c4cb456196C776D2d9093Dd62C9B0")
+	opts := NewUnsignedTransactOpts(context.Background(), from)
+
+	sender, ok := senderFromContext(opts.Context)
+	assert.True(t, ok)
+	assert.Equal(t, from, sender)
+
+	tx := types.Transaction{}
+	signed, err := opts.Signer(from, &tx)
+	assert.NoError(t, err)
+	assert.Equal(t, &tx, signed)
+
+	_, err = opts.Signer(common.Address{}, &tx)
+	assert.ErrorIs(t, err, bind.ErrNotAuthorized)
+}
+
+func TestConvertFilterQuery(t *testing.T) {
+	q := convertFilterQuery(ethereum.FilterQuery{FromBlock: big.NewInt(10)})
+	assert.Equal(t, types.NewBlockNumber(10), *q.FromBlock)
+	assert.Nil(t, q.ToBlock)
+}
+
+// contractBackend serves the eth namespace for ClientForContract, calls block until release is closed.
+type contractBackend struct {
+	release chan struct{}
+	sent    chan types.TransactionArgs
+}
+
+func (b *contractBackend) GetCode(ctx context.Context, account common.Address, blockNum types.BlockNumberOrHash) (hexutil.Bytes, error) {
+	<-b.release
+	return nil, nil
+}
+
+// GetBlockByNumber returns a block without mixHash and nonce, like parity and conflux espace.
+func (b *contractBackend) GetBlockByNumber(blockNum types.BlockNumber, fullTx bool) json.RawMessage {
+	return json.RawMessage(`{"difficulty":"0x0","extraData":"0x","gasLimit":"0x1c9c380","gasUsed":"0x0","hash":"0x0000000000000000000000000000000000000000000000000000000000000001","logsBloom":"0x00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","miner":"0x0000000000000000000000000000000000001234","number":"0x10","parentHash":"0x0000000000000000000000000000000000000000000000000000000000000000","receiptsRoot":"0x0000000000000000000000000000000000000000000000000000000000000000","sha3Uncles":"0x0000000000000000000000000000000000000000000000000000000000000000","size":"0x100","stateRoot":"0x0000000000000000000000000000000000000000000000000000000000000000","timestamp":"0x64","transactions":[],"transactionsRoot":"0x0000000000000000000000000000000000000000000000000000000000000000","uncles":[]}`)
+}
+
+func (b *contractBackend) Logs(ctx context.Context, query types.FilterQuery) (*rpc.Subscription, error) {
+	<-b.release
+	notifier, _ := rpc.NotifierFromContext(ctx)
+	sub := notifier.CreateSubscription()
+	go func() {
+		notifier.Notify(sub.ID, &ethtypes.Log{Address: query.Addresses[0], Topics: []common.Hash{}, Data: []byte{}})
+	}()
+	return sub, nil
+}
+
+func (b *contractBackend) GasPrice() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(1))
+}
+
+func (b *contractBackend) MaxPriorityFeePerGas() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(1))
+}
+
+func (b *contractBackend) GetTransactionCount(account common.Address, blockNum types.BlockNumberOrHash) hexutil.Uint64 {
+	return 0
+}
+
+func (b *contractBackend) ChainId() hexutil.Uint64 {
+	return 1
+}
+
+func (b *contractBackend) SendTransaction(args types.TransactionArgs) common.Hash {
+	b.sent <- args
+	return common.Hash{}
+}
+
+func newTestClientForContract(t *testing.T) (*ClientForContract, *contractBackend) {
+	backend := &contractBackend{release: make(chan struct{}), sent: make(chan types.TransactionArgs, 1)}
+	server := rpc.NewServer()
+	assert.NoError(t, server.RegisterName("eth", backend))
+	t.Cleanup(server.Stop)
+	t.Cleanup(func() { close(backend.release) })
+	return NewClientForContract(NewClientWithProvider(rpc.DialInProc(server))), backend
+}
+
+func TestClientForContractContext(t *testing.T) {
+	a := assert.New(t)
+	c, _ := newTestClientForContract(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := c.CodeAt(ctx, common.Address{}, nil)
+	a.ErrorIs(err, context.DeadlineExceeded)
+
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = c.SubscribeFilterLogs(ctx, ethereum.FilterQuery{Addresses: []common.Address{{}}}, make(chan ethtypes.Log))
+	a.ErrorIs(err, context.DeadlineExceeded)
+}
+
+func TestClientForContractSubscribeFilterLogs(t *testing.T) {
+	a := assert.New(t)
+	c, backend := newTestClientForContract(t)
+
+	address := common.HexToAddress("0x1234")
+	ch := make(chan ethtypes.Log)
+	go func() { backend.release <- struct{}{} }()
+	sub, err := c.SubscribeFilterLogs(context.Background(), ethereum.FilterQuery{Addresses: []common.Address{address}}, ch)
+	a.NoError(err)
+	defer sub.Unsubscribe()
+
+	select {
+	case log := <-ch:
+		a.Equal(address, log.Address)
+	case err := <-sub.Err():
+		a.Fail("subscription failed", err)
+	case <-time.After(time.Second):
+		a.Fail("timeout")
+	}
+}
+
+func TestClientForContractHeaderWithoutSeal(t *testing.T) {
+	a := assert.New(t)
+	c, _ := newTestClientForContract(t)
+
+	header, err := c.HeaderByNumber(context.Background(), big.NewInt(16))
+	a.NoError(err)
+	a.Equal(big.NewInt(16), header.Number)
+	a.Equal(common.HexToAddress("0x1234"), header.Coinbase)
+	a.Equal(common.Hash{}, header.MixDigest)
+	a.Equal(ethtypes.BlockNonce{}, header.Nonce)
+}
+
+func TestClientForContractSendTransaction(t *testing.T) {
+	a := assert.New(t)
+	c, backend := newTestClientForContract(t)
+
+	from := common.HexToAddress("0xe6D148D8398c4cb456196C776D2d9093Dd62C9B0")
+	to := common.HexToAddress("0x1234")
+	tx := ethtypes.NewTx(&ethtypes.DynamicFeeTx{To: &to, Gas: 21000, Value: big.NewInt(1), GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(2)})
+
+	opts := NewUnsignedTransactOpts(context.Background(), from)
+	a.NoError(c.SendTransaction(opts.Context, tx))
+	select {
+	case args := <-backend.sent:
+		a.Equal(from, *args.From)
+		a.Equal(to, *args.To)
+	default:
+		a.Fail("transaction not sent")
+	}
+
+	// fallback to the signer selected by signer manager
+	a.ErrorIs(c.SendTransaction(context.Background(), tx), ErrNotFound)
+
+	sm := signers.MustNewSignerManagerByMnemonic("crisp shove million stem shiver side hospital split play lottery join vintage", 2, nil)
+	c.raw.option = &ClientOption{SignerManager: sm}
+	a.NoError(c.SendTransaction(context.Background(), tx))
+	select {
+	case args := <-backend.sent:
+		a.Equal(sm.List()[0].Address(), *args.From)
+	default:
+		a.Fail("transaction not sent")
+	}
+
+	sm.SetSelector(signers.SignerSelectorFunc(func(list []interfaces.Signer) (interfaces.Signer, error) {
+		return list[len(list)-1], nil
+	}))
+	a.NoError(c.SendTransaction(context.Background(), tx))
+	select {
+	case args := <-backend.sent:
+		a.Equal(sm.List()[1].Address(), *args.From)
+	default:
+		a.Fail("transaction not sent")
+	}
 }
@@ -0,0 +1,103 @@
+package web3go
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/openweb3/web3go/types"
+	"github.com/pkg/errors"
+)
+
+// TransactOverrides specifies the transaction fields of TransactOpts, nil fields are populated
+// by TransactionArgs.Populate the same way as SendTransactionByArgs.
+type TransactOverrides struct {
+	Context              context.Context
+	Value                *big.Int
+	Nonce                *uint64
+	GasLimit             uint64
+	GasPrice             *big.Int
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	AccessList           *ethtypes.AccessList
+	TxType               *uint8
+	NoSend               bool
+}
+
+// TransactOpts returns TransactOpts for abi-binding struct generated by abigen, the transaction is signed by
+// the signer of from in signer manager.
+//
+// Before signing, the nonce, gas price and transaction type filled by abigen are discarded and populated by
+// TransactionArgs.Populate unless they are specified in overrides, so that transactions sent by binding
+// behave the same as SendTransactionByArgs. Chain id is cached by client.
+func (c *Client) TransactOpts(from common.Address, overrides ...TransactOverrides) (*bind.TransactOpts, error) {
+	sm, err := c.GetSignerManager()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get signer manager")
+	}
+
+	signer, err := sm.Get(from)
+	if err != nil {
+		return nil, err
+	}
+
+	var o TransactOverrides
+	if len(overrides) > 0 {
+		o = overrides[0]
+	}
+
+	ctx := o.Context
+	if ctx == nil {
+		ctx = c.context
+	}
+
+	opts := &bind.TransactOpts{
+		From:     from,
+		Value:    o.Value,
+		GasLimit: o.GasLimit,
+		Context:  ctx,
+		NoSend:   o.NoSend,
+	}
+	if o.Nonce != nil {
+		opts.Nonce = new(big.Int).SetUint64(*o.Nonce)
+	}
+	if o.AccessList != nil {
+		opts.AccessList = *o.AccessList
+	}
+
+	opts.Signer = func(addr common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		if addr != from {
+			return nil, bind.ErrNotAuthorized
+		}
+
+		chainId, err := c.ChainId()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get chain id")
+		}
+
+		args := types.ConvertTransactionToArgs(from, tx)
+		args.Nonce = (*hexutil.Uint64)(o.Nonce)
+		args.GasPrice = (*hexutil.Big)(o.GasPrice)
+		args.MaxFeePerGas = (*hexutil.Big)(o.MaxFeePerGas)
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(o.MaxPriorityFeePerGas)
+		args.AccessList = o.AccessList
+		args.TxType = o.TxType
+		args.ChainID = (*hexutil.Big)(chainId)
+
+		eth := *c.Eth
+		if ctx != nil {
+			eth.SetContext(ctx)
+		}
+
+		populated, err := args.PopulateAndToTransaction(&eth)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to populate transaction")
+		}
+		return signer.SignTransaction(populated, chainId)
+	}
+
+	return opts, nil
+}
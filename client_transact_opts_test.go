@@ -0,0 +1,105 @@
+package web3go
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	rpc "github.com/openweb3/go-rpc-provider"
+	"github.com/openweb3/web3go/signers"
+	"github.com/openweb3/web3go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockEthAPI serves the eth methods used by TransactionArgs.Populate
+type mockEthAPI struct {
+	chainIdCalls int
+}
+
+func (m *mockEthAPI) ChainId() hexutil.Uint64 {
+	m.chainIdCalls++
+	return 0x12
+}
+
+func (m *mockEthAPI) GasPrice() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(0x1c))
+}
+
+func (m *mockEthAPI) MaxPriorityFeePerGas() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(0x1e))
+}
+
+func (m *mockEthAPI) GetBlockByNumber(number types.BlockNumber, isFull bool) map[string]interface{} {
+	return map[string]interface{}{
+		"number":        "0x1",
+		"difficulty":    "0x0",
+		"baseFeePerGas": "0x3a",
+		"transactions":  []interface{}{},
+	}
+}
+
+func (m *mockEthAPI) GetTransactionCount(addr common.Address, block *types.BlockNumberOrHash) hexutil.Uint64 {
+	return 0x30
+}
+
+func newMockClient(t *testing.T, sm *signers.SignerManager) (*Client, *mockEthAPI) {
+	api := &mockEthAPI{}
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", api); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(server.Stop)
+
+	c := NewClientWithProvider(rpc.DialInProc(server))
+	c.option = &ClientOption{SignerManager: sm}
+	return c, api
+}
+
+func TestTransactOpts(t *testing.T) {
+	a := assert.New(t)
+	sm := signers.MustNewSignerManagerByPrivateKeyStrings([]string{"9ec393923a14eeb557600010ea05d635c667a6995418f8a8f4bdecc63dfe0bb9"})
+	from := sm.List()[0].Address()
+	c, api := newMockClient(t, sm)
+
+	to := common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+	// transaction built by abigen
+	rawTx := ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 1, GasPrice: big.NewInt(1), Gas: 50000, To: &to, Data: []byte{1}})
+
+	opts, err := c.TransactOpts(from)
+	a.NoError(err)
+
+	signed, err := opts.Signer(from, rawTx)
+	a.NoError(err)
+	a.Equal(uint8(ethtypes.DynamicFeeTxType), signed.Type())
+	a.Equal(uint64(0x30), signed.Nonce())
+	a.Equal(uint64(50000), signed.Gas())
+	a.Equal(big.NewInt(0x1e), signed.GasTipCap())
+	a.Equal(big.NewInt(0x3a*2+0x1e), signed.GasFeeCap())
+	a.Equal(big.NewInt(0x12), signed.ChainId())
+
+	sender, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(big.NewInt(0x12)), signed)
+	a.NoError(err)
+	a.Equal(from, sender)
+
+	nonce := uint64(7)
+	opts, err = c.TransactOpts(from, TransactOverrides{Nonce: &nonce, GasPrice: big.NewInt(100)})
+	a.NoError(err)
+	a.Equal(big.NewInt(7), opts.Nonce)
+
+	signed, err = opts.Signer(from, rawTx)
+	a.NoError(err)
+	a.Equal(uint8(ethtypes.LegacyTxType), signed.Type())
+	a.Equal(uint64(7), signed.Nonce())
+	a.Equal(big.NewInt(100), signed.GasPrice())
+
+	// chain id is cached
+	a.Equal(1, api.chainIdCalls)
+
+	_, err = opts.Signer(to, rawTx)
+	a.Error(err)
+
+	_, err = c.TransactOpts(to)
+	a.Error(err)
+}
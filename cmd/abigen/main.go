@@ -0,0 +1,102 @@
+// Command abigen generates Go bindings of Ethereum contracts against web3go.Client.
+//
+// Usage:
+//
+//	abigen --abi token.abi --bin token.bin --pkg token --type Token --out token.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/openweb3/web3go/abigen"
+)
+
+var (
+	abiFlag   = flag.String("abi", "", "Path to the Ethereum contract ABI json to bind, - for STDIN")
+	binFlag   = flag.String("bin", "", "Path to the Ethereum contract bytecode (generate deploy method)")
+	typeFlag  = flag.String("type", "", "Struct name for the binding (default = package name)")
+	pkgFlag   = flag.String("pkg", "", "Package name to generate the binding into")
+	outFlag   = flag.String("out", "", "Output file for the generated binding (default = stdout)")
+	aliasFlag = flag.String("alias", "", "Comma separated aliases for function, event and error renaming, e.g. original1=alias1, original2=alias2")
+)
+
+func main() {
+	flag.Parse()
+
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "abigen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	if *pkgFlag == "" {
+		return fmt.Errorf("no destination package specified (--pkg)")
+	}
+	if *abiFlag == "" {
+		return fmt.Errorf("no contract abi specified (--abi)")
+	}
+
+	abiJson, err := readFile(*abiFlag)
+	if err != nil {
+		return fmt.Errorf("failed to read input ABI: %v", err)
+	}
+
+	var bytecode []byte
+	if *binFlag != "" {
+		if bytecode, err = os.ReadFile(*binFlag); err != nil {
+			return fmt.Errorf("failed to read input bytecode: %v", err)
+		}
+	}
+
+	typ := *typeFlag
+	if typ == "" {
+		typ = *pkgFlag
+	}
+
+	aliases, err := parseAliases(*aliasFlag)
+	if err != nil {
+		return err
+	}
+
+	code, err := abigen.Bind([]string{typ}, []string{string(abiJson)}, []string{string(bytecode)}, *pkgFlag, aliases)
+	if err != nil {
+		return fmt.Errorf("failed to generate ABI binding: %v", err)
+	}
+
+	if *outFlag == "" {
+		fmt.Print(code)
+		return nil
+	}
+	if err := os.WriteFile(*outFlag, []byte(code), 0600); err != nil {
+		return fmt.Errorf("failed to write ABI binding: %v", err)
+	}
+	return nil
+}
+
+func readFile(path string) ([]byte, error) {
+	if path == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(path)
+}
+
+func parseAliases(s string) (map[string]string, error) {
+	aliases := make(map[string]string)
+	if s == "" {
+		return aliases, nil
+	}
+
+	for _, item := range strings.Split(s, ",") {
+		pair := strings.Split(strings.TrimSpace(item), "=")
+		if len(pair) != 2 || pair[0] == "" || pair[1] == "" {
+			return nil, fmt.Errorf("invalid alias %q", item)
+		}
+		aliases[pair[0]] = pair[1]
+	}
+	return aliases, nil
+}
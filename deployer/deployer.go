@@ -0,0 +1,206 @@
+// Package deployer deploys contracts to predictable addresses, by CREATE2 through the deterministic deployment
+// proxy or by CREATE with the address predicted from sender and nonce.
+package deployer
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/mcuadros/go-defaults"
+	"github.com/openweb3/web3go/client"
+	"github.com/openweb3/web3go/types"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrReceiptTimeout    = errors.New("timeout to wait for transaction receipt")
+	ErrTransactionFailed = errors.New("transaction failed")
+	ErrNoCodeDeployed    = errors.New("no code deployed")
+)
+
+// Create2Address returns the address of contract deployed by the deterministic deployment proxy with salt and init code.
+func Create2Address(salt common.Hash, initCode []byte) common.Address {
+	return crypto.CreateAddress2(ProxyAddress, salt, crypto.Keccak256(initCode))
+}
+
+// CreateAddress returns the address of contract deployed by sender with nonce.
+func CreateAddress(sender common.Address, nonce uint64) common.Address {
+	return crypto.CreateAddress(sender, nonce)
+}
+
+type Option struct {
+	// PollInterval is the interval to poll the receipt of transaction.
+	PollInterval time.Duration `default:"1s"`
+	// Timeout is the max duration to wait for the receipt of transaction.
+	Timeout time.Duration `default:"5m"`
+}
+
+func (o *Option) setDefault() *Option {
+	defaults.SetDefaults(o)
+	return o
+}
+
+// Deployer deploys contracts with transactions sent by RpcEthClient.SendTransactionByArgs, all methods
+// return after the receipt of deployment transaction is available.
+type Deployer struct {
+	eth    *client.RpcEthClient
+	option Option
+}
+
+func NewDeployer(eth *client.RpcEthClient, option ...Option) *Deployer {
+	var opt Option
+	if len(option) > 0 {
+		opt = option[0]
+	}
+	opt.setDefault()
+
+	return &Deployer{
+		eth:    eth,
+		option: opt,
+	}
+}
+
+// DeployProxy deploys the deterministic deployment proxy if it is absent. ProxySignerAddress is funded by
+// args.From first if its balance is not enough to pay ProxyDeploymentCost.
+//
+// Note the presigned ProxyDeploymentTx is not replay-protected and pays 100 gwei gas price, nodes rejecting
+// unprotected transactions or requiring higher gas price can not deploy the proxy.
+func (d *Deployer) DeployProxy(args types.TransactionArgs) error {
+	code, err := d.eth.CodeAt(ProxyAddress, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to get code of proxy")
+	}
+	if len(code) > 0 {
+		return nil
+	}
+
+	balance, err := d.eth.Balance(ProxySignerAddress, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to get balance of proxy signer")
+	}
+
+	if balance.Cmp(ProxyDeploymentCost) < 0 {
+		args.To = &ProxySignerAddress
+		args.Value = (*hexutil.Big)(new(big.Int).Sub(ProxyDeploymentCost, balance))
+		args.Data = nil
+
+		txHash, err := d.eth.SendTransactionByArgs(args)
+		if err != nil {
+			return errors.Wrap(err, "failed to fund proxy signer")
+		}
+		if _, err = d.waitForReceipt(txHash); err != nil {
+			return errors.Wrap(err, "failed to fund proxy signer")
+		}
+	}
+
+	txHash, err := d.eth.SendRawTransaction(ProxyDeploymentTx)
+	if err != nil {
+		return errors.Wrap(err, "failed to send proxy deployment transaction")
+	}
+	if _, err = d.waitForReceipt(txHash); err != nil {
+		return errors.Wrap(err, "failed to deploy proxy")
+	}
+
+	return d.checkCode(ProxyAddress)
+}
+
+// DeployCreate2 deploys the contract by the deterministic deployment proxy with salt and init code, and returns
+// the CREATE2 address. The deployment is skipped if there is code at the address already, and the proxy is
+// deployed first if it is absent.
+//
+// args specifies the sender and optional fee fields, its To and Data are overwritten.
+func (d *Deployer) DeployCreate2(args types.TransactionArgs, salt common.Hash, initCode []byte) (common.Address, error) {
+	address := Create2Address(salt, initCode)
+
+	code, err := d.eth.CodeAt(address, nil)
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "failed to get code")
+	}
+	if len(code) > 0 {
+		return address, nil
+	}
+
+	if err := d.DeployProxy(args); err != nil {
+		return common.Address{}, err
+	}
+
+	data := hexutil.Bytes(append(salt.Bytes(), initCode...))
+	args.To = &ProxyAddress
+	args.Data = &data
+
+	txHash, err := d.eth.SendTransactionByArgs(args)
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "failed to send deployment transaction")
+	}
+	if _, err = d.waitForReceipt(txHash); err != nil {
+		return common.Address{}, err
+	}
+
+	if err := d.checkCode(address); err != nil {
+		return common.Address{}, err
+	}
+	return address, nil
+}
+
+// DeployCreate deploys the contract with init code args.Data by CREATE, and returns the address predicted
+// by sender and the nonce populated by TransactionArgs.Populate.
+func (d *Deployer) DeployCreate(args types.TransactionArgs) (common.Address, error) {
+	args.To = nil
+	if err := args.Populate(d.eth); err != nil {
+		return common.Address{}, errors.Wrap(err, "failed to populate transaction")
+	}
+	address := CreateAddress(*args.From, uint64(*args.Nonce))
+
+	txHash, err := d.eth.SendTransactionByArgs(args)
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "failed to send deployment transaction")
+	}
+
+	receipt, err := d.waitForReceipt(txHash)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	if receipt.ContractAddress != nil && *receipt.ContractAddress != address {
+		return common.Address{}, errors.Errorf("contract deployed at %v but predicted %v", receipt.ContractAddress, address)
+	}
+	return address, nil
+}
+
+// waitForReceipt polls the receipt of transaction until it is available, error is returned if the transaction failed.
+func (d *Deployer) waitForReceipt(txHash common.Hash) (*types.Receipt, error) {
+	deadline := time.Now().Add(d.option.Timeout)
+	for {
+		receipt, err := d.eth.TransactionReceipt(txHash)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get receipt of transaction %v", txHash)
+		}
+
+		if receipt != nil {
+			if receipt.Status != nil && *receipt.Status != ethtypes.ReceiptStatusSuccessful {
+				return nil, errors.Wrapf(ErrTransactionFailed, "transaction %v", txHash)
+			}
+			return receipt, nil
+		}
+
+		if time.Now().After(deadline) {
+			return nil, errors.Wrapf(ErrReceiptTimeout, "transaction %v", txHash)
+		}
+		time.Sleep(d.option.PollInterval)
+	}
+}
+
+func (d *Deployer) checkCode(address common.Address) error {
+	code, err := d.eth.CodeAt(address, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to get code")
+	}
+	if len(code) == 0 {
+		return errors.Wrapf(ErrNoCodeDeployed, "address %v", address)
+	}
+	return nil
+}
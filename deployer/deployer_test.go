@@ -0,0 +1,181 @@
+package deployer
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+	rpc "github.com/openweb3/go-rpc-provider"
+	"github.com/openweb3/web3go/client"
+	"github.com/openweb3/web3go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	sender = common.HexToAddress("0x000000000000000000000000000000000000a11c")
+	// init code returning runtime code 0x00
+	initCode = hexutil.MustDecode("0x60016000f3")
+)
+
+// evmChain executes transactions immediately on an in-memory state with the go-ethereum EVM.
+type evmChain struct {
+	state    *state.StateDB
+	receipts map[common.Hash]map[string]interface{}
+	sent     int
+}
+
+func newEvmChain() *evmChain {
+	statedb, _ := state.New(ethtypes.EmptyRootHash, state.NewDatabaseForTesting())
+	statedb.AddBalance(sender, uint256.NewInt(1e18), 0)
+	return &evmChain{state: statedb, receipts: make(map[common.Hash]map[string]interface{})}
+}
+
+func (c *evmChain) ChainId() hexutil.Uint64 { return 1 }
+
+func (c *evmChain) GasPrice() *hexutil.Big { return (*hexutil.Big)(big.NewInt(1)) }
+
+func (c *evmChain) MaxPriorityFeePerGas() *hexutil.Big { return (*hexutil.Big)(big.NewInt(1)) }
+
+func (c *evmChain) GetBlockByNumber(number types.BlockNumber, isFull bool) map[string]interface{} {
+	return map[string]interface{}{"number": "0x1", "difficulty": "0x0", "baseFeePerGas": "0x1", "transactions": []interface{}{}}
+}
+
+func (c *evmChain) EstimateGas(req types.CallRequest, block *types.BlockNumberOrHash, overrides *types.StateOverride, blockOverrides *types.BlockOverrides) hexutil.Uint64 {
+	return 1000000
+}
+
+func (c *evmChain) GetTransactionCount(addr common.Address, block *types.BlockNumberOrHash) hexutil.Uint64 {
+	return hexutil.Uint64(c.state.GetNonce(addr))
+}
+
+func (c *evmChain) GetBalance(addr common.Address, block *types.BlockNumberOrHash) *hexutil.Big {
+	return (*hexutil.Big)(c.state.GetBalance(addr).ToBig())
+}
+
+func (c *evmChain) GetCode(addr common.Address, block *types.BlockNumberOrHash) hexutil.Bytes {
+	return c.state.GetCode(addr)
+}
+
+func (c *evmChain) GetTransactionReceipt(txHash common.Hash) map[string]interface{} {
+	return c.receipts[txHash]
+}
+
+func (c *evmChain) SendTransaction(args types.TransactionArgs) common.Hash {
+	var data []byte
+	if args.Data != nil {
+		data = *args.Data
+	}
+	return c.execute(*args.From, args.To, data, args.Value.ToInt())
+}
+
+func (c *evmChain) SendRawTransaction(raw hexutil.Bytes) (common.Hash, error) {
+	tx := new(ethtypes.Transaction)
+	if err := tx.UnmarshalBinary(raw); err != nil {
+		return common.Hash{}, err
+	}
+	from, err := ethtypes.Sender(ethtypes.HomesteadSigner{}, tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	cost := new(big.Int).Mul(tx.GasPrice(), new(big.Int).SetUint64(tx.Gas()))
+	c.state.SubBalance(from, uint256.MustFromBig(cost), 0)
+	return c.execute(from, tx.To(), tx.Data(), tx.Value()), nil
+}
+
+func (c *evmChain) execute(from common.Address, to *common.Address, data []byte, value *big.Int) common.Hash {
+	c.sent++
+	cfg := &runtime.Config{State: c.state, Origin: from, GasLimit: math.MaxUint64 / 2, Value: value}
+
+	var err error
+	receipt := map[string]interface{}{}
+	if to == nil {
+		var address common.Address
+		_, address, _, err = runtime.Create(data, cfg)
+		receipt["contractAddress"] = address
+	} else {
+		c.state.SetNonce(from, c.state.GetNonce(from)+1, 0)
+		_, _, err = runtime.Call(*to, data, cfg)
+	}
+
+	txHash := common.BigToHash(big.NewInt(int64(c.sent)))
+	receipt["transactionHash"] = txHash
+	receipt["status"] = "0x1"
+	if err != nil {
+		receipt["status"] = "0x0"
+	}
+	c.receipts[txHash] = receipt
+	return txHash
+}
+
+func newTestDeployer(t *testing.T) (*Deployer, *evmChain) {
+	chain := newEvmChain()
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", chain); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(server.Stop)
+	return NewDeployer(client.NewRpcEthClient(rpc.DialInProc(server)), Option{PollInterval: time.Millisecond}), chain
+}
+
+func TestProxyDeploymentTx(t *testing.T) {
+	a := assert.New(t)
+
+	tx := new(ethtypes.Transaction)
+	a.NoError(tx.UnmarshalBinary(ProxyDeploymentTx))
+
+	signer, err := ethtypes.Sender(ethtypes.HomesteadSigner{}, tx)
+	a.NoError(err)
+	a.Equal(ProxySignerAddress, signer)
+	a.Equal(ProxyAddress, CreateAddress(signer, tx.Nonce()))
+	a.Equal(ProxyDeploymentCost, tx.Cost())
+}
+
+func TestDeployCreate2(t *testing.T) {
+	a := assert.New(t)
+	d, chain := newTestDeployer(t)
+
+	salt := common.HexToHash("0x01")
+	address, err := d.DeployCreate2(types.TransactionArgs{From: &sender}, salt, initCode)
+	a.NoError(err)
+	a.Equal(Create2Address(salt, initCode), address)
+	a.Equal([]byte{0}, chain.state.GetCode(address))
+	a.Equal(ProxyRuntimeCode, chain.state.GetCode(ProxyAddress))
+	// fund proxy signer, deploy proxy and deploy contract
+	a.Equal(3, chain.sent)
+
+	// skip if code exists
+	address, err = d.DeployCreate2(types.TransactionArgs{From: &sender}, salt, initCode)
+	a.NoError(err)
+	a.Equal(Create2Address(salt, initCode), address)
+	a.Equal(3, chain.sent)
+
+	// reuse the deployed proxy
+	address, err = d.DeployCreate2(types.TransactionArgs{From: &sender}, common.HexToHash("0x02"), initCode)
+	a.NoError(err)
+	a.Equal(Create2Address(common.HexToHash("0x02"), initCode), address)
+	a.Equal(4, chain.sent)
+
+	// proxy reverts if the init code fails
+	_, err = d.DeployCreate2(types.TransactionArgs{From: &sender}, salt, hexutil.MustDecode("0x60006000fd"))
+	a.ErrorIs(err, ErrTransactionFailed)
+}
+
+func TestDeployCreate(t *testing.T) {
+	a := assert.New(t)
+	d, chain := newTestDeployer(t)
+	chain.state.SetNonce(sender, 7, 0)
+
+	data := hexutil.Bytes(initCode)
+	address, err := d.DeployCreate(types.TransactionArgs{From: &sender, Data: &data})
+	a.NoError(err)
+	a.Equal(crypto.CreateAddress(sender, 7), address)
+	a.Equal([]byte{0}, chain.state.GetCode(address))
+}
@@ -0,0 +1,24 @@
+package deployer
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// The canonical deterministic deployment proxy, see https://github.com/Arachnid/deterministic-deployment-proxy.
+// The proxy is deployed by a presigned transaction without chain id, so it has the same address on every chain.
+var (
+	// ProxyAddress is the address of deterministic deployment proxy, calldata of proxy is
+	// 32 bytes salt followed by init code, and the CREATE2 address is returned.
+	ProxyAddress = common.HexToAddress("0x4e59b44847b379578588920ca78fbf26c0b4956c")
+	// ProxySignerAddress is the sender of ProxyDeploymentTx.
+	ProxySignerAddress = common.HexToAddress("0x3fab184622dc19b6109349b94811493bf2a45362")
+	// ProxyDeploymentTx is the presigned legacy transaction deploying proxy with nonce 0, gas 100000 and gas price 100 gwei.
+	ProxyDeploymentTx = hexutil.MustDecode("0xf8a58085174876e800830186a08080b853604580600e600039806000f350fe7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe03601600081602082378035828234f58015156039578182fd5b8082525050506014600cf31ba02222222222222222222222222222222222222222222222222222222222222222a02222222222222222222222222222222222222222222222222222222222222222")
+	// ProxyDeploymentCost is the balance of ProxySignerAddress required by ProxyDeploymentTx.
+	ProxyDeploymentCost = new(big.Int).Mul(big.NewInt(100000), big.NewInt(100_000_000_000))
+	// ProxyRuntimeCode is the runtime code of proxy.
+	ProxyRuntimeCode = hexutil.MustDecode("0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe03601600081602082378035828234f58015156039578182fd5b8082525050506014600cf3")
+)
@@ -0,0 +1,254 @@
+// Package eip7702 builds, signs and validates EIP-7702 SetCode authorizations, and inspects delegations of
+// accounts.
+package eip7702
+
+import (
+	"math"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+	"github.com/openweb3/web3go/client"
+	"github.com/openweb3/web3go/interfaces"
+	"github.com/openweb3/web3go/types"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidAuthorization = errors.New("invalid authorization")
+)
+
+// AuthorizationRequest requests the signer to delegate its account to the code of Delegate, the zero address
+// clears the delegation.
+type AuthorizationRequest struct {
+	Signer   interfaces.Signer
+	Delegate common.Address
+	// AnyChain signs the authorization with chain ID 0 which is valid on all chains, it is replayable on other
+	// chains and should be used with care.
+	AnyChain bool
+}
+
+// Builder builds authorizations with chain ID and nonce read from chain.
+//
+// The nonce of an authorization must be the nonce of authority when it is processed, which is after the nonce of
+// transaction sender is increased and after previous authorizations of the same authority in the list. So the
+// nonce of a self-sponsored authorization, whose authority is the transaction sender, is the transaction nonce + 1.
+type Builder struct {
+	eth *client.RpcEthClient
+}
+
+func NewBuilder(eth *client.RpcEthClient) *Builder {
+	return &Builder{eth}
+}
+
+// Authorize signs authorizations of requests and appends them to args.AuthorizationList of the SetCode
+// transaction sent by args.From. The nonce of transaction is populated first if it is nil, so that nonces of
+// self-sponsored authorizations are consistent with the transaction.
+func (b *Builder) Authorize(args *types.TransactionArgs, requests ...AuthorizationRequest) error {
+	if args.From == nil {
+		return errors.New("from is required")
+	}
+
+	if args.Nonce == nil {
+		nonce, err := b.pendingNonce(*args.From)
+		if err != nil {
+			return err
+		}
+		args.Nonce = (*hexutil.Uint64)(&nonce)
+	}
+
+	chainID, err := b.chainID()
+	if err != nil {
+		return err
+	}
+
+	nonces, err := b.initNonces(*args.From, uint64(*args.Nonce), args.AuthorizationList)
+	if err != nil {
+		return err
+	}
+
+	for i, request := range requests {
+		authority := request.Signer.Address()
+		nonce, err := nonces.next(authority)
+		if err != nil {
+			return err
+		}
+
+		auth := ethtypes.SetCodeAuthorization{Address: request.Delegate, Nonce: nonce}
+		if !request.AnyChain {
+			auth.ChainID = *uint256.NewInt(chainID)
+		}
+
+		signed, err := request.Signer.SignSetCodeAuthorization(auth)
+		if err != nil {
+			return errors.WithMessagef(err, "failed to sign authorization of request %v", i)
+		}
+		args.AuthorizationList = append(args.AuthorizationList, signed)
+	}
+
+	return nil
+}
+
+// Sign signs the authorization of a request to be sent by sender, the nonce of sender is read from chain. Use
+// Authorize to build several authorizations of a transaction.
+func (b *Builder) Sign(sender common.Address, request AuthorizationRequest) (ethtypes.SetCodeAuthorization, error) {
+	args := types.TransactionArgs{From: &sender}
+	if err := b.Authorize(&args, request); err != nil {
+		return ethtypes.SetCodeAuthorization{}, err
+	}
+	return args.AuthorizationList[0], nil
+}
+
+// Validate checks the SetCode transaction and its authorizations before sending, the nonce of transaction is
+// read from chain if args.Nonce is nil. Authorizations are checked by ValidateAuthorization, and nonces of
+// authorities must match the chain, and authorities must have no code other than delegation.
+//
+// Note invalid authorizations are skipped by the chain instead of failing the transaction, so they are better to
+// be found before sending.
+func (b *Builder) Validate(args types.TransactionArgs) error {
+	if args.From == nil {
+		return errors.New("from is required")
+	}
+	if args.To == nil {
+		return errors.New("to address is required for SetCode transaction")
+	}
+	if len(args.AuthorizationList) == 0 {
+		return errors.New("empty authorization list")
+	}
+
+	var nonce uint64
+	if args.Nonce != nil {
+		nonce = uint64(*args.Nonce)
+	} else {
+		var err error
+		if nonce, err = b.pendingNonce(*args.From); err != nil {
+			return err
+		}
+	}
+
+	chainID, err := b.chainID()
+	if err != nil {
+		return err
+	}
+
+	nonces, err := b.initNonces(*args.From, nonce, nil)
+	if err != nil {
+		return err
+	}
+
+	for i, auth := range args.AuthorizationList {
+		authority, err := ValidateAuthorization(auth, chainID)
+		if err != nil {
+			return errors.WithMessagef(err, "authorization[%v]", i)
+		}
+
+		code, err := b.eth.CodeAt(authority, nil)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get code of %v", authority)
+		}
+		if _, ok := ethtypes.ParseDelegation(code); len(code) > 0 && !ok {
+			return errors.Wrapf(ErrInvalidAuthorization, "authorization[%v]: authority %v has code", i, authority)
+		}
+
+		expected, err := nonces.next(authority)
+		if err != nil {
+			return err
+		}
+		if auth.Nonce != expected {
+			return errors.Wrapf(ErrInvalidAuthorization, "authorization[%v]: nonce of authority %v should be %v but got %v",
+				i, authority, expected, auth.Nonce)
+		}
+	}
+
+	return nil
+}
+
+// Delegation returns the address that account delegates to, or nil if the account is not delegated.
+func (b *Builder) Delegation(account common.Address) (*common.Address, error) {
+	code, err := b.eth.CodeAt(account, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get code")
+	}
+	if delegate, ok := ethtypes.ParseDelegation(code); ok {
+		return &delegate, nil
+	}
+	return nil, nil
+}
+
+// ValidateAuthorization checks the chain ID, nonce and signature of authorization, and returns its authority.
+func ValidateAuthorization(auth ethtypes.SetCodeAuthorization, chainID uint64) (common.Address, error) {
+	if !auth.ChainID.IsZero() && auth.ChainID.CmpUint64(chainID) != 0 {
+		return common.Address{}, errors.Wrapf(ErrInvalidAuthorization, "chain ID should be 0 or %v but got %v", chainID, auth.ChainID.Dec())
+	}
+	if auth.Nonce == math.MaxUint64 {
+		return common.Address{}, errors.Wrap(ErrInvalidAuthorization, "nonce overflow")
+	}
+	if auth.V == 0 && auth.R.IsZero() && auth.S.IsZero() {
+		return common.Address{}, errors.Wrap(ErrInvalidAuthorization, "not signed")
+	}
+
+	authority, err := auth.Authority()
+	if err != nil {
+		return common.Address{}, errors.Wrapf(ErrInvalidAuthorization, "invalid signature: %v", err)
+	}
+	return authority, nil
+}
+
+func (b *Builder) chainID() (uint64, error) {
+	chainID, err := b.eth.ChainId()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get chain ID")
+	}
+	if chainID == nil {
+		return 0, errors.New("chain ID not available")
+	}
+	return *chainID, nil
+}
+
+func (b *Builder) pendingNonce(address common.Address) (uint64, error) {
+	pending := types.BlockNumberOrHashWithNumber(types.PendingBlockNumber)
+	nonce, err := b.eth.TransactionCount(address, &pending)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get nonce of %v", address)
+	}
+	return nonce.Uint64(), nil
+}
+
+// initNonces returns nonces of authorities after the transaction nonce of sender is increased and existing
+// authorizations are processed.
+func (b *Builder) initNonces(sender common.Address, txNonce uint64, existing []ethtypes.SetCodeAuthorization) (*authorityNonces, error) {
+	nonces := &authorityNonces{
+		builder: b,
+		nonces:  map[common.Address]uint64{sender: txNonce + 1},
+	}
+	for _, auth := range existing {
+		authority, err := auth.Authority()
+		if err != nil {
+			continue
+		}
+		if _, err := nonces.next(authority); err != nil {
+			return nil, err
+		}
+	}
+	return nonces, nil
+}
+
+// authorityNonces tracks the nonces of authorities when authorizations of the list are processed in order.
+type authorityNonces struct {
+	builder *Builder
+	nonces  map[common.Address]uint64
+}
+
+// next returns the nonce of the next authorization of authority.
+func (n *authorityNonces) next(authority common.Address) (uint64, error) {
+	nonce, ok := n.nonces[authority]
+	if !ok {
+		var err error
+		if nonce, err = n.builder.pendingNonce(authority); err != nil {
+			return 0, err
+		}
+	}
+	n.nonces[authority] = nonce + 1
+	return nonce, nil
+}
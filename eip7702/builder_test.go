@@ -0,0 +1,146 @@
+package eip7702
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+	rpc "github.com/openweb3/go-rpc-provider"
+	"github.com/openweb3/web3go/client"
+	"github.com/openweb3/web3go/signers"
+	"github.com/openweb3/web3go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	delegate = common.HexToAddress("0x000000000000000000000000000000000000de1e")
+	contract = common.HexToAddress("0x000000000000000000000000000000000000c0de")
+)
+
+type fakeChain struct {
+	nonces map[common.Address]uint64
+	codes  map[common.Address][]byte
+}
+
+func (c *fakeChain) ChainId() hexutil.Uint64 { return 5 }
+
+func (c *fakeChain) GetTransactionCount(addr common.Address, block *types.BlockNumberOrHash) hexutil.Uint64 {
+	return hexutil.Uint64(c.nonces[addr])
+}
+
+func (c *fakeChain) GetCode(addr common.Address, block *types.BlockNumberOrHash) hexutil.Bytes {
+	return c.codes[addr]
+}
+
+func newTestBuilder(t *testing.T) (*Builder, *fakeChain) {
+	chain := &fakeChain{nonces: map[common.Address]uint64{}, codes: map[common.Address][]byte{}}
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", chain); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(server.Stop)
+	return NewBuilder(client.NewRpcEthClient(rpc.DialInProc(server))), chain
+}
+
+func TestAuthorize(t *testing.T) {
+	a := assert.New(t)
+	b, chain := newTestBuilder(t)
+	sender := signers.MustNewRandomPrivateKeySigner()
+	other := signers.MustNewRandomPrivateKeySigner()
+	chain.nonces[sender.Address()] = 3
+	chain.nonces[other.Address()] = 7
+
+	from := sender.Address()
+	args := types.TransactionArgs{From: &from, To: &from}
+	err := b.Authorize(&args,
+		AuthorizationRequest{Signer: sender, Delegate: delegate},
+		AuthorizationRequest{Signer: other, Delegate: delegate, AnyChain: true},
+		AuthorizationRequest{Signer: sender, Delegate: common.Address{}},
+	)
+	a.NoError(err)
+	a.Equal(hexutil.Uint64(3), *args.Nonce)
+	a.Len(args.AuthorizationList, 3)
+
+	// self-sponsored authorizations follow the transaction nonce
+	a.Equal(uint64(4), args.AuthorizationList[0].Nonce)
+	a.Equal(uint64(5), args.AuthorizationList[2].Nonce)
+	a.Equal(uint64(7), args.AuthorizationList[1].Nonce)
+	a.Equal(uint64(5), args.AuthorizationList[0].ChainID.Uint64())
+	a.True(args.AuthorizationList[1].ChainID.IsZero())
+
+	for i, expected := range []common.Address{sender.Address(), other.Address(), sender.Address()} {
+		authority, err := args.AuthorizationList[i].Authority()
+		a.NoError(err)
+		a.Equal(expected, authority)
+	}
+	a.NoError(b.Validate(args))
+
+	// append to existing authorizations with the specified transaction nonce
+	args = types.TransactionArgs{From: &from, To: &from, Nonce: (*hexutil.Uint64)(new(uint64))}
+	a.NoError(b.Authorize(&args, AuthorizationRequest{Signer: sender, Delegate: delegate}))
+	a.NoError(b.Authorize(&args, AuthorizationRequest{Signer: sender, Delegate: delegate}))
+	a.Equal(uint64(1), args.AuthorizationList[0].Nonce)
+	a.Equal(uint64(2), args.AuthorizationList[1].Nonce)
+
+	// sponsored by others
+	auth, err := b.Sign(other.Address(), AuthorizationRequest{Signer: sender, Delegate: delegate})
+	a.NoError(err)
+	a.Equal(uint64(3), auth.Nonce)
+}
+
+func TestValidate(t *testing.T) {
+	a := assert.New(t)
+	b, chain := newTestBuilder(t)
+	sender := signers.MustNewRandomPrivateKeySigner()
+	from := sender.Address()
+
+	sign := func(auth ethtypes.SetCodeAuthorization) ethtypes.SetCodeAuthorization {
+		signed, err := sender.SignSetCodeAuthorization(auth)
+		a.NoError(err)
+		return signed
+	}
+	validate := func(auth ethtypes.SetCodeAuthorization) error {
+		return b.Validate(types.TransactionArgs{From: &from, To: &from, AuthorizationList: []ethtypes.SetCodeAuthorization{auth}})
+	}
+
+	a.NoError(validate(sign(ethtypes.SetCodeAuthorization{ChainID: *uint256.NewInt(5), Address: delegate, Nonce: 1})))
+	a.ErrorIs(validate(sign(ethtypes.SetCodeAuthorization{ChainID: *uint256.NewInt(1), Address: delegate, Nonce: 1})), ErrInvalidAuthorization)
+	// nonce of self-sponsored authorization must be the transaction nonce + 1
+	a.ErrorIs(validate(sign(ethtypes.SetCodeAuthorization{ChainID: *uint256.NewInt(5), Address: delegate, Nonce: 0})), ErrInvalidAuthorization)
+	a.ErrorIs(validate(ethtypes.SetCodeAuthorization{ChainID: *uint256.NewInt(5), Address: delegate, Nonce: 1}), ErrInvalidAuthorization)
+
+	tampered := sign(ethtypes.SetCodeAuthorization{ChainID: *uint256.NewInt(5), Address: delegate, Nonce: 1})
+	tampered.S = *new(uint256.Int).Sub(uint256.MustFromHex("0xfffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141"), &tampered.S)
+	a.ErrorIs(validate(tampered), ErrInvalidAuthorization)
+
+	// authority delegated already is able to delegate again, but not contracts
+	chain.codes[from] = ethtypes.AddressToDelegation(contract)
+	a.NoError(validate(sign(ethtypes.SetCodeAuthorization{ChainID: *uint256.NewInt(5), Address: delegate, Nonce: 1})))
+	chain.codes[from] = []byte{0x00}
+	a.ErrorIs(validate(sign(ethtypes.SetCodeAuthorization{ChainID: *uint256.NewInt(5), Address: delegate, Nonce: 1})), ErrInvalidAuthorization)
+
+	a.Error(b.Validate(types.TransactionArgs{From: &from, To: &from}))
+	a.Error(b.Validate(types.TransactionArgs{From: &from, AuthorizationList: []ethtypes.SetCodeAuthorization{{}}}))
+}
+
+func TestDelegation(t *testing.T) {
+	a := assert.New(t)
+	b, chain := newTestBuilder(t)
+	account := common.HexToAddress("0x000000000000000000000000000000000000a11c")
+
+	delegated, err := b.Delegation(account)
+	a.NoError(err)
+	a.Nil(delegated)
+
+	chain.codes[account] = ethtypes.AddressToDelegation(delegate)
+	delegated, err = b.Delegation(account)
+	a.NoError(err)
+	a.Equal(delegate, *delegated)
+
+	chain.codes[account] = []byte{0x00}
+	delegated, err = b.Delegation(account)
+	a.NoError(err)
+	a.Nil(delegated)
+}
@@ -78,7 +78,7 @@ func TestTraceCall(t *testing.T) {
 
 	c := client.NewRpcDebugClient(provider)
 
-	blkNum := types.NewBlockNumber(179904465)
+	blkNum := types.BlockNumberOrHashWithNumber(types.NewBlockNumber(179904465))
 	to := common.HexToAddress("0x807da62384be660ded0319d613d8b37cf3892d20")
 	val, err := c.TraceCall(types.CallRequest{
 		To: &to,
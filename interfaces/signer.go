@@ -18,6 +18,9 @@ type Signer interface {
 	// SignMessage signs a message using the Ethereum signed message prefix (EIP-191).
 	SignMessage(text []byte) ([]byte, error)
 
+	// SignTypedData signs the EIP-712 digest of typed structured data.
+	SignTypedData(typedData web3types.TypedData) ([]byte, error)
+
 	// SignHash signs a precomputed 32-byte hash directly without message prefixing.
 	SignHash(hash common.Hash) ([]byte, error)
 
@@ -0,0 +1,45 @@
+package multicall
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Multicall3Address is the address Multicall3 is deployed at on most EVM chains.
+// See https://github.com/mds1/multicall for the list of supported chains.
+var Multicall3Address = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+// Multicall3ABI is the subset of the Multicall3 ABI used by Multicall.
+const Multicall3ABI = `[{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bool","name":"allowFailure","type":"bool"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall3.Call3[]","name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall3.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}]`
+
+// aggregate3OverrideCode is a minimal runtime that only implements `aggregate3`
+// with the same ABI and revert semantics as Multicall3. It is injected through
+// state override when Multicall3 is not deployed on the target chain, so the
+// reads could still be batched in a single eth_call.
+var aggregate3OverrideCode = hexutil.MustDecode("0x60003560e01c6382ad56cb146100155760006000fd5b6004356004018035600052602001606052602061010052600051610120526000516020026101400160405260006020525b6000516020511015610113576020516020026060510135606051016080526080516020013560a052608051604001356080510160c05260c0513560c051602001604051606001376000600060c051356040516060016000608051355af18060a051176100b7573d600060003e3d6000fd5b604051526040604051602001523d604051604001523d60006040516060013e60003d6040516060010152610140604051036020516020026101400152601f3d01601f191660405101606001604052602051600101602052610046565b61010060405103610100f3")
+
+var multicall3Abi abi.ABI
+
+func init() {
+	var err error
+	multicall3Abi, err = abi.JSON(strings.NewReader(Multicall3ABI))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// call3 is the go representation of struct Multicall3.Call3
+type call3 struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+// result3 is the go representation of struct Multicall3.Result
+type result3 struct {
+	Success    bool   `json:"success"`
+	ReturnData []byte `json:"returnData"`
+}
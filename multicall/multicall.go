@@ -0,0 +1,257 @@
+// Package multicall batches contract reads into a single eth_call through Multicall3.
+package multicall
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/mcuadros/go-defaults"
+	"github.com/openweb3/web3go/client"
+	"github.com/openweb3/web3go/types"
+	"github.com/pkg/errors"
+)
+
+// Call is a single contract call aggregated by Multicall.
+type Call struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+	// Gas is the expected gas used by the call, it is only used to split aggregates.
+	// Zero means Option.DefaultCallGas.
+	Gas uint64
+
+	abi    *abi.ABI
+	method string
+}
+
+// NewCall packs the method and arguments with the contract abi, the return data
+// will be decoded into Result.Values if the call succeeds.
+func NewCall(target common.Address, contractAbi *abi.ABI, method string, args ...interface{}) (*Call, error) {
+	data, err := contractAbi.Pack(method, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to pack method %v", method)
+	}
+	return &Call{
+		Target:   target,
+		CallData: data,
+		abi:      contractAbi,
+		method:   method,
+	}, nil
+}
+
+func MustNewCall(target common.Address, contractAbi *abi.ABI, method string, args ...interface{}) *Call {
+	c, err := NewCall(target, contractAbi, method, args...)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Result is the result of a single aggregated call.
+type Result struct {
+	Success    bool
+	ReturnData []byte
+	// Values are the decoded outputs, only available for successful calls created by NewCall.
+	Values []interface{}
+}
+
+type Option struct {
+	// Address of Multicall3 contract, zero address means Multicall3Address.
+	Address common.Address
+	// MaxCalldataSize is the max size in bytes of the aggregate3 calldata of one eth_call.
+	MaxCalldataSize int `default:"120000"`
+	// MaxBatchGas is the max sum of Call.Gas aggregated in one eth_call.
+	MaxBatchGas uint64 `default:"25000000"`
+	// DefaultCallGas is used as Call.Gas if it is not specified.
+	DefaultCallGas uint64 `default:"1000000"`
+	// DeployIfMissing injects Multicall3 by state override if there is no code at Address.
+	DeployIfMissing bool
+	// OverrideCode is the runtime code injected when DeployIfMissing is set,
+	// default is a minimal runtime implementing aggregate3.
+	OverrideCode []byte
+}
+
+func (o *Option) setDefault() *Option {
+	defaults.SetDefaults(o)
+	if o.Address == (common.Address{}) {
+		o.Address = Multicall3Address
+	}
+	if len(o.OverrideCode) == 0 {
+		o.OverrideCode = aggregate3OverrideCode
+	}
+	return o
+}
+
+// Multicall aggregates contract calls with Multicall3 on top of RpcEthClient.Call
+type Multicall struct {
+	eth    *client.RpcEthClient
+	option Option
+
+	mutex sync.Mutex
+	// deployed is true once Multicall3 is found at the latest block
+	deployed bool
+}
+
+func NewMulticall(eth *client.RpcEthClient, option ...Option) *Multicall {
+	var opt Option
+	if len(option) > 0 {
+		opt = option[0]
+	}
+	opt.setDefault()
+
+	return &Multicall{
+		eth:    eth,
+		option: opt,
+	}
+}
+
+// Aggregate3 executes calls by Multicall3 `aggregate3` at the given block, calls are split
+// into several eth_call if the calldata size or gas exceeds the limits of option.
+// Results are returned in the same order as calls.
+func (m *Multicall) Aggregate3(calls []*Call, blockNum *types.BlockNumberOrHash) ([]*Result, error) {
+	overrides, err := m.stateOverride(blockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*Result, 0, len(calls))
+	for _, batch := range m.split(calls) {
+		batchResults, err := m.aggregate3(batch, blockNum, overrides)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, batchResults...)
+	}
+	return results, nil
+}
+
+func (m *Multicall) aggregate3(calls []*Call, blockNum *types.BlockNumberOrHash, overrides *types.StateOverride) ([]*Result, error) {
+	input := make([]call3, len(calls))
+	for i, c := range calls {
+		input[i] = call3{
+			Target:       c.Target,
+			AllowFailure: c.AllowFailure,
+			CallData:     c.CallData,
+		}
+	}
+
+	data, err := multicall3Abi.Pack("aggregate3", input)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to pack aggregate3")
+	}
+
+	output, err := m.eth.Call(types.CallRequest{
+		To:   &m.option.Address,
+		Data: data,
+	}, blockNum, overrides, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call aggregate3")
+	}
+
+	unpacked, err := multicall3Abi.Unpack("aggregate3", output)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unpack aggregate3")
+	}
+
+	raws := *abi.ConvertType(unpacked[0], new([]result3)).(*[]result3)
+	if len(raws) != len(calls) {
+		return nil, errors.Errorf("expect %v results but got %v", len(calls), len(raws))
+	}
+
+	results := make([]*Result, len(raws))
+	for i, raw := range raws {
+		results[i] = &Result{
+			Success:    raw.Success,
+			ReturnData: raw.ReturnData,
+		}
+
+		c := calls[i]
+		if !raw.Success || c.abi == nil {
+			continue
+		}
+
+		results[i].Values, err = c.abi.Unpack(c.method, raw.ReturnData)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to unpack result of call %v", c.method)
+		}
+	}
+	return results, nil
+}
+
+// split splits calls into batches which calldata size and gas are in the limits of option.
+// A single call exceeding the limits is placed in its own batch.
+func (m *Multicall) split(calls []*Call) [][]*Call {
+	var batches [][]*Call
+	var current []*Call
+	// abi encoded aggregate3 calldata: selector, array offset and length
+	size, gas := 4+64, uint64(0)
+
+	for _, c := range calls {
+		// element offset, target, allowFailure, callData offset, callData length and padded data
+		callSize := 32*5 + (len(c.CallData)+31)/32*32
+		callGas := c.Gas
+		if callGas == 0 {
+			callGas = m.option.DefaultCallGas
+		}
+
+		if len(current) > 0 && (size+callSize > m.option.MaxCalldataSize || gas+callGas > m.option.MaxBatchGas) {
+			batches = append(batches, current)
+			current, size, gas = nil, 4+64, 0
+		}
+
+		current = append(current, c)
+		size += callSize
+		gas += callGas
+	}
+
+	if len(current) > 0 {
+		batches = append(batches, current)
+	}
+	return batches
+}
+
+// stateOverride returns the state override to inject Multicall3 if it is missing and option DeployIfMissing is set.
+// Only the deployment found at the latest block is cached, other queries are checked every time, since Multicall3
+// may be not deployed yet at historical blocks.
+func (m *Multicall) stateOverride(blockNum *types.BlockNumberOrHash) (*types.StateOverride, error) {
+	if !m.option.DeployIfMissing {
+		return nil, nil
+	}
+
+	latest := isLatest(blockNum)
+	m.mutex.Lock()
+	deployed := m.deployed
+	m.mutex.Unlock()
+	if latest && deployed {
+		return nil, nil
+	}
+
+	code, err := m.eth.CodeAt(m.option.Address, blockNum)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get code of multicall3")
+	}
+	if len(code) > 0 {
+		if latest {
+			m.mutex.Lock()
+			m.deployed = true
+			m.mutex.Unlock()
+		}
+		return nil, nil
+	}
+
+	override := hexutil.Bytes(m.option.OverrideCode)
+	return &types.StateOverride{
+		m.option.Address: {Code: &override},
+	}, nil
+}
+
+// isLatest returns true if blockNum is nil or the latest block number.
+func isLatest(blockNum *types.BlockNumberOrHash) bool {
+	if blockNum == nil {
+		return true
+	}
+	number, ok := blockNum.Number()
+	return ok && number == types.LatestBlockNumber
+}
@@ -0,0 +1,188 @@
+package multicall
+
+import (
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	rpc "github.com/openweb3/go-rpc-provider"
+	"github.com/openweb3/web3go/client"
+	"github.com/openweb3/web3go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	// returns calldata without the 4 bytes selector
+	echoCode   = hexutil.MustDecode("0x600436036004600037600436036000f3")
+	revertCode = hexutil.MustDecode("0x60006000fd")
+
+	echoAddr   = common.HexToAddress("0x0000000000000000000000000000000000001001")
+	revertAddr = common.HexToAddress("0x0000000000000000000000000000000000001002")
+
+	echoAbi = mustParseAbi(`[{"inputs":[{"name":"v","type":"uint256"}],"name":"echo","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"}]`)
+)
+
+func mustParseAbi(s string) *abi.ABI {
+	a, err := abi.JSON(strings.NewReader(s))
+	if err != nil {
+		panic(err)
+	}
+	return &a
+}
+
+// evmEthAPI serves eth_call and eth_getCode by executing the in-memory state with the go-ethereum EVM.
+type evmEthAPI struct {
+	state *state.StateDB
+	calls int
+	// Multicall3 in state is only returned by eth_getCode at blocks since deployedAt, and at latest
+	deployedAt    uint64
+	codeRequests  int
+	lastOverrides *types.StateOverride
+}
+
+func newEvmEthAPI() *evmEthAPI {
+	statedb, _ := state.New(ethtypes.EmptyRootHash, state.NewDatabaseForTesting())
+	statedb.SetCode(echoAddr, echoCode)
+	statedb.SetCode(revertAddr, revertCode)
+	return &evmEthAPI{state: statedb}
+}
+
+func (api *evmEthAPI) GetCode(addr common.Address, block *types.BlockNumberOrHash) (hexutil.Bytes, error) {
+	api.codeRequests++
+	if number, ok := block.Number(); ok && number >= 0 && uint64(number) < api.deployedAt {
+		return nil, nil
+	}
+	return api.state.GetCode(addr), nil
+}
+
+func (api *evmEthAPI) Call(req types.CallRequest, block *types.BlockNumberOrHash, overrides *types.StateOverride, blockOverrides *types.BlockOverrides) (hexutil.Bytes, error) {
+	api.calls++
+	api.lastOverrides = overrides
+	statedb := api.state.Copy()
+	if overrides != nil {
+		for addr, account := range *overrides {
+			if account.Code != nil {
+				statedb.SetCode(addr, *account.Code)
+			}
+		}
+	}
+	ret, _, err := runtime.Call(*req.To, req.Data, &runtime.Config{State: statedb, GasLimit: math.MaxUint64 / 2})
+	return ret, err
+}
+
+func newTestMulticall(t *testing.T, option Option) (*Multicall, *evmEthAPI) {
+	api := newEvmEthAPI()
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", api); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(server.Stop)
+	return NewMulticall(client.NewRpcEthClient(rpc.DialInProc(server)), option), api
+}
+
+func TestAggregate3WithStateOverride(t *testing.T) {
+	a := assert.New(t)
+	m, _ := newTestMulticall(t, Option{DeployIfMissing: true})
+
+	calls := []*Call{
+		MustNewCall(echoAddr, echoAbi, "echo", big.NewInt(1)),
+		{Target: revertAddr, AllowFailure: true},
+		MustNewCall(echoAddr, echoAbi, "echo", big.NewInt(3)),
+	}
+	results, err := m.Aggregate3(calls, nil)
+	a.NoError(err)
+	a.Len(results, 3)
+
+	a.True(results[0].Success)
+	a.Equal([]interface{}{big.NewInt(1)}, results[0].Values)
+	a.False(results[1].Success)
+	a.Nil(results[1].Values)
+	a.True(results[2].Success)
+	a.Equal([]interface{}{big.NewInt(3)}, results[2].Values)
+
+	// revert the whole aggregate if failure is not allowed
+	calls[1].AllowFailure = false
+	_, err = m.Aggregate3(calls, nil)
+	a.Error(err)
+}
+
+func TestAggregate3WithoutMulticall(t *testing.T) {
+	m, _ := newTestMulticall(t, Option{})
+	results, err := m.Aggregate3([]*Call{MustNewCall(echoAddr, echoAbi, "echo", big.NewInt(1))}, nil)
+	assert.Error(t, err)
+	assert.Nil(t, results)
+}
+
+func TestAggregate3Split(t *testing.T) {
+	a := assert.New(t)
+	m, api := newTestMulticall(t, Option{DeployIfMissing: true, MaxBatchGas: 2_000_000})
+
+	var calls []*Call
+	for i := 0; i < 5; i++ {
+		calls = append(calls, MustNewCall(echoAddr, echoAbi, "echo", big.NewInt(int64(i))))
+	}
+	results, err := m.Aggregate3(calls, nil)
+	a.NoError(err)
+	a.Equal(3, api.calls)
+	for i, r := range results {
+		a.Equal(int64(i), r.Values[0].(*big.Int).Int64())
+	}
+}
+
+func TestAggregate3DeployedCheck(t *testing.T) {
+	a := assert.New(t)
+	m, api := newTestMulticall(t, Option{DeployIfMissing: true})
+	api.state.SetCode(Multicall3Address, aggregate3OverrideCode)
+	api.deployedAt = 10
+
+	calls := []*Call{MustNewCall(echoAddr, echoAbi, "echo", big.NewInt(1))}
+	aggregate := func(blockNum *types.BlockNumberOrHash) {
+		results, err := m.Aggregate3(calls, blockNum)
+		a.NoError(err)
+		a.Equal([]interface{}{big.NewInt(1)}, results[0].Values)
+	}
+
+	// deployment at latest is cached
+	aggregate(nil)
+	a.Nil(api.lastOverrides)
+	aggregate(types.Pointer(types.BlockNumberOrHashWithNumber(types.LatestBlockNumber)))
+	a.Nil(api.lastOverrides)
+	a.Equal(1, api.codeRequests)
+
+	// historical blocks are checked every time
+	aggregate(types.Pointer(types.BlockNumberOrHashWithNumber(5)))
+	a.NotNil(api.lastOverrides)
+	aggregate(types.Pointer(types.BlockNumberOrHashWithNumber(20)))
+	a.Nil(api.lastOverrides)
+	a.Equal(3, api.codeRequests)
+
+	// missing at an old block first doesn't affect later queries
+	m, api = newTestMulticall(t, Option{DeployIfMissing: true})
+	api.state.SetCode(Multicall3Address, aggregate3OverrideCode)
+	api.deployedAt = 10
+	aggregate(types.Pointer(types.BlockNumberOrHashWithNumber(5)))
+	a.NotNil(api.lastOverrides)
+	aggregate(nil)
+	a.Nil(api.lastOverrides)
+}
+
+func TestSplitByCalldataSize(t *testing.T) {
+	a := assert.New(t)
+	m := NewMulticall(nil, Option{MaxCalldataSize: 1100})
+
+	calls := []*Call{
+		{CallData: make([]byte, 100)},
+		{CallData: make([]byte, 500)},
+		{CallData: make([]byte, 2000)},
+		{CallData: make([]byte, 10)},
+	}
+	batches := m.split(calls)
+	a.Equal([][]*Call{{calls[0], calls[1]}, {calls[2]}, {calls[3]}}, batches)
+}
@@ -48,7 +48,7 @@ func NewSignableProvider(p pinterfaces.Provider, signManager *signers.SignerMana
 
 func (s *SignableMiddleware) CallContextMiddleware(call pproviders.CallContextFunc) pproviders.CallContextFunc {
 	return func(ctx context.Context, resultPtr interface{}, method string, args ...interface{}) error {
-		if method == METHOD_SEND_TRANSACTION {
+		if method == METHOD_SEND_TRANSACTION && !types.IsWithoutSigning(ctx) {
 			// Intercept eth_sendTransaction and try local signing first.
 			// When signing succeeds, rewrite request to eth_sendRawTransaction.
 			rawTx, err := s.signTxAndEncode(args[0])
@@ -64,6 +64,10 @@ func (s *SignableMiddleware) CallContextMiddleware(call pproviders.CallContextFu
 
 func (s *SignableMiddleware) BatchCallContextMiddleware(batchCall pproviders.BatchCallContextFunc) pproviders.BatchCallContextFunc {
 	return func(ctx context.Context, b []rpc.BatchElem) error {
+		if types.IsWithoutSigning(ctx) {
+			return batchCall(ctx, b)
+		}
+
 		for i := range b {
 			if b[i].Method == METHOD_SEND_TRANSACTION {
 				// Batch variant of the same interception/rewrite behavior as CallContextMiddleware.
@@ -105,11 +109,14 @@ func (s *SignableMiddleware) signTxAndEncode(tx interface{}) (hexutil.Bytes, err
 
 	var signer interfaces.Signer
 	if txArgs.From == nil {
-		signers := s.manager.List()
-		if len(signers) == 0 {
+		var err error
+		signer, err = s.manager.Select()
+		if err == signers.ErrSignerNotFound {
 			return nil, ErrNoSigner
 		}
-		signer = signers[0]
+		if err != nil {
+			return nil, err
+		}
 	} else {
 		var err error
 		signer, err = s.manager.Get(*txArgs.From)
@@ -0,0 +1,200 @@
+package signers
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	web3types "github.com/openweb3/web3go/types"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidDERSignature = errors.New("invalid DER signature")
+	ErrInvalidPublicKey    = errors.New("invalid secp256k1 public key")
+
+	secp256k1N     = crypto.S256().Params().N
+	secp256k1HalfN = new(big.Int).Rsh(secp256k1N, 1)
+
+	oidPublicKeyECDSA = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidSecp256k1      = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+)
+
+// DigestSignerBackend signs 32-byte digests with a secp256k1 key, such as cloud KMS and HSM services.
+type DigestSignerBackend interface {
+	// PublicKey returns the public key of the signing key.
+	PublicKey() (*ecdsa.PublicKey, error)
+	// SignDigest signs the digest and returns the ASN.1 DER encoded ECDSA signature without recovery id.
+	SignDigest(digest []byte) ([]byte, error)
+}
+
+// DigestSigner adapts a DigestSignerBackend to interfaces.Signer. The DER signatures of backend are normalized
+// to low S as required by Ethereum, and the recovery id is computed by recovering with the public key.
+type DigestSigner struct {
+	backend DigestSignerBackend
+	address common.Address
+	pubKey  []byte
+}
+
+// NewDigestSigner creates DigestSigner by the public key of backend.
+func NewDigestSigner(backend DigestSignerBackend) (*DigestSigner, error) {
+	pubKey, err := backend.PublicKey()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get public key")
+	}
+	if pubKey == nil || pubKey.Curve != crypto.S256() {
+		return nil, ErrInvalidPublicKey
+	}
+
+	return &DigestSigner{
+		backend: backend,
+		address: crypto.PubkeyToAddress(*pubKey),
+		pubKey:  crypto.FromECDSAPub(pubKey),
+	}, nil
+}
+
+func (d *DigestSigner) Address() common.Address {
+	return d.address
+}
+
+// SignHash signs the hash by backend and returns the 65 bytes signature [R || S || V] with V 0 or 1.
+func (d *DigestSigner) SignHash(hash common.Hash) ([]byte, error) {
+	der, err := d.backend.SignDigest(hash[:])
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign digest")
+	}
+
+	var parsed struct{ R, S *big.Int }
+	rest, err := asn1.Unmarshal(der, &parsed)
+	if err != nil || len(rest) > 0 {
+		return nil, ErrInvalidDERSignature
+	}
+	if parsed.R.Sign() <= 0 || parsed.R.Cmp(secp256k1N) >= 0 || parsed.S.Sign() <= 0 || parsed.S.Cmp(secp256k1N) >= 0 {
+		return nil, ErrInvalidDERSignature
+	}
+
+	s := parsed.S
+	if s.Cmp(secp256k1HalfN) > 0 {
+		s = new(big.Int).Sub(secp256k1N, s)
+	}
+
+	sig := make([]byte, crypto.SignatureLength)
+	parsed.R.FillBytes(sig[:32])
+	s.FillBytes(sig[32:64])
+
+	for v := byte(0); v < 2; v++ {
+		sig[crypto.RecoveryIDOffset] = v
+		pubKey, err := crypto.Ecrecover(hash[:], sig)
+		if err == nil && bytes.Equal(pubKey, d.pubKey) {
+			return sig, nil
+		}
+	}
+	return nil, errors.Errorf("signature is not signed by %v", d.address)
+}
+
+func (d *DigestSigner) SignTransaction(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	signer := types.LatestSignerForChainID(chainID)
+	sig, err := d.SignHash(signer.Hash(tx))
+	if err != nil {
+		return nil, err
+	}
+	return tx.WithSignature(signer, sig)
+}
+
+func (d *DigestSigner) SignMessage(text []byte) ([]byte, error) {
+	return d.SignHash(common.BytesToHash(accounts.TextHash(text)))
+}
+
+func (d *DigestSigner) SignTypedData(typedData web3types.TypedData) ([]byte, error) {
+	hash, err := web3types.TypedDataHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+	return d.SignHash(hash)
+}
+
+func (d *DigestSigner) SignSetCodeAuthorization(auth types.SetCodeAuthorization) (types.SetCodeAuthorization, error) {
+	sig, err := d.SignHash(SetCodeAuthorizationHash(auth))
+	if err != nil {
+		return types.SetCodeAuthorization{}, err
+	}
+	return withSetCodeSignature(auth, sig), nil
+}
+
+// SetCodeAuthorizationHash returns the EIP-7702 signing hash keccak256(0x05 || rlp([chain_id, address, nonce])).
+// It mirrors SetCodeAuthorization.sigHash of go-ethereum, which is unexported in v1.15, use it instead once it
+// is exported.
+func SetCodeAuthorizationHash(auth types.SetCodeAuthorization) common.Hash {
+	encoded, _ := rlp.EncodeToBytes([]interface{}{&auth.ChainID, auth.Address, auth.Nonce})
+	return crypto.Keccak256Hash([]byte{0x05}, encoded)
+}
+
+// withSetCodeSignature sets the 65 bytes signature [R || S || V] to authorization.
+func withSetCodeSignature(auth types.SetCodeAuthorization, sig []byte) types.SetCodeAuthorization {
+	auth.R.SetBytes(sig[:32])
+	auth.S.SetBytes(sig[32:64])
+	auth.V = sig[crypto.RecoveryIDOffset]
+	return auth
+}
+
+func (d DigestSigner) String() string {
+	return fmt.Sprintf("address: %v", d.address.Hex())
+}
+
+// ParsePKIXPublicKey parses the DER encoded SubjectPublicKeyInfo of secp256k1 public key, which is the format
+// returned by most KMS services.
+func ParsePKIXPublicKey(der []byte) (*ecdsa.PublicKey, error) {
+	var info struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	rest, err := asn1.Unmarshal(der, &info)
+	if err != nil || len(rest) > 0 {
+		return nil, errors.Wrap(ErrInvalidPublicKey, "invalid SubjectPublicKeyInfo")
+	}
+
+	var curve asn1.ObjectIdentifier
+	if !info.Algorithm.Algorithm.Equal(oidPublicKeyECDSA) {
+		return nil, errors.Wrap(ErrInvalidPublicKey, "not ECDSA public key")
+	}
+	if _, err := asn1.Unmarshal(info.Algorithm.Parameters.FullBytes, &curve); err != nil || !curve.Equal(oidSecp256k1) {
+		return nil, errors.Wrap(ErrInvalidPublicKey, "not secp256k1 curve")
+	}
+
+	pubKey, err := crypto.UnmarshalPubkey(info.PublicKey.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidPublicKey, err.Error())
+	}
+	return pubKey, nil
+}
+
+// MemoryDigestBackend is an in-memory DigestSignerBackend with randomized ECDSA signatures, the S of which
+// may be high, it is used for testing the DigestSigner.
+type MemoryDigestBackend struct {
+	privateKey *ecdsa.PrivateKey
+}
+
+func NewMemoryDigestBackend(privateKey *ecdsa.PrivateKey) *MemoryDigestBackend {
+	return &MemoryDigestBackend{privateKey: privateKey}
+}
+
+func (m *MemoryDigestBackend) PublicKey() (*ecdsa.PublicKey, error) {
+	return &m.privateKey.PublicKey, nil
+}
+
+func (m *MemoryDigestBackend) SignDigest(digest []byte) ([]byte, error) {
+	r, s, err := ecdsa.Sign(rand.Reader, m.privateKey, digest)
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(struct{ R, S *big.Int }{r, s})
+}
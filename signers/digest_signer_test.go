@@ -0,0 +1,152 @@
+package signers
+
+import (
+	"crypto/ecdsa"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/assert"
+)
+
+// highSBackend signs deterministically and always returns the high S form of signature.
+type highSBackend struct {
+	privateKey *ecdsa.PrivateKey
+}
+
+func (h *highSBackend) PublicKey() (*ecdsa.PublicKey, error) {
+	return &h.privateKey.PublicKey, nil
+}
+
+func (h *highSBackend) SignDigest(digest []byte) ([]byte, error) {
+	sig, err := crypto.Sign(digest, h.privateKey)
+	if err != nil {
+		return nil, err
+	}
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).Sub(secp256k1N, new(big.Int).SetBytes(sig[32:64]))
+	return asn1.Marshal(struct{ R, S *big.Int }{r, s})
+}
+
+func TestDigestSigner(t *testing.T) {
+	a := assert.New(t)
+	key, _ := crypto.GenerateKey()
+	expected := NewPrivateKeySigner(key)
+
+	for _, backend := range []DigestSignerBackend{NewMemoryDigestBackend(key), &highSBackend{key}} {
+		signer, err := NewDigestSigner(backend)
+		a.NoError(err)
+		a.Equal(expected.Address(), signer.Address())
+
+		// signatures are normalized to low S and recoverable
+		for i := 0; i < 16; i++ {
+			msg := []byte{byte(i)}
+			sig, err := signer.SignMessage(msg)
+			a.NoError(err)
+			a.True(new(big.Int).SetBytes(sig[32:64]).Cmp(secp256k1HalfN) <= 0)
+			a.True(crypto.VerifySignature(crypto.FromECDSAPub(&key.PublicKey), accounts.TextHash(msg), sig[:64]))
+
+			recovered, err := RecoverHash(common.BytesToHash(accounts.TextHash(msg)), sig)
+			a.NoError(err)
+			a.Equal(expected.Address(), recovered)
+		}
+
+		chainID := big.NewInt(1)
+		tx := types.NewTx(&types.DynamicFeeTx{ChainID: chainID, Nonce: 1, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(2), Gas: 21000})
+		signed, err := signer.SignTransaction(tx, chainID)
+		a.NoError(err)
+		sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+		a.NoError(err)
+		a.Equal(expected.Address(), sender)
+
+		typedData := mustParseTypedData(mailTypedData)
+		sig, err := signer.SignTypedData(typedData)
+		a.NoError(err)
+		ok, err := VerifyTypedData(typedData, sig, expected.Address())
+		a.NoError(err)
+		a.True(ok)
+
+		auth := types.SetCodeAuthorization{ChainID: *uint256.NewInt(1), Address: common.HexToAddress("0x01"), Nonce: 3}
+		signedAuth, err := signer.SignSetCodeAuthorization(auth)
+		a.NoError(err)
+		authority, err := signedAuth.Authority()
+		a.NoError(err)
+		a.Equal(expected.Address(), authority)
+	}
+}
+
+func TestDigestSignerInvalid(t *testing.T) {
+	a := assert.New(t)
+	key, _ := crypto.GenerateKey()
+	other, _ := crypto.GenerateKey()
+
+	// backend signs with a key different from its public key
+	signer, err := NewDigestSigner(&mismatchBackend{NewMemoryDigestBackend(other), &key.PublicKey})
+	a.NoError(err)
+	_, err = signer.SignHash(common.Hash{0x01})
+	a.Error(err)
+
+	signer, err = NewDigestSigner(&mismatchBackend{&invalidDERBackend{}, &key.PublicKey})
+	a.NoError(err)
+	_, err = signer.SignHash(common.Hash{0x01})
+	a.ErrorIs(err, ErrInvalidDERSignature)
+}
+
+type mismatchBackend struct {
+	DigestSignerBackend
+	pubKey *ecdsa.PublicKey
+}
+
+func (m *mismatchBackend) PublicKey() (*ecdsa.PublicKey, error) { return m.pubKey, nil }
+
+type invalidDERBackend struct{ DigestSignerBackend }
+
+func (i *invalidDERBackend) SignDigest(digest []byte) ([]byte, error) {
+	return []byte{0x30, 0x00, 0x01}, nil
+}
+
+func TestParsePKIXPublicKey(t *testing.T) {
+	a := assert.New(t)
+	key, _ := crypto.GenerateKey()
+
+	params, _ := asn1.Marshal(oidSecp256k1)
+	der, _ := asn1.Marshal(struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}{
+		Algorithm: pkix.AlgorithmIdentifier{Algorithm: oidPublicKeyECDSA, Parameters: asn1.RawValue{FullBytes: params}},
+		PublicKey: asn1.BitString{Bytes: crypto.FromECDSAPub(&key.PublicKey), BitLength: 65 * 8},
+	})
+
+	pubKey, err := ParsePKIXPublicKey(der)
+	a.NoError(err)
+	a.Equal(crypto.PubkeyToAddress(key.PublicKey), crypto.PubkeyToAddress(*pubKey))
+
+	_, err = ParsePKIXPublicKey(der[1:])
+	a.ErrorIs(err, ErrInvalidPublicKey)
+}
+
+func TestSetCodeAuthorizationHash(t *testing.T) {
+	a := assert.New(t)
+	key, _ := crypto.GenerateKey()
+
+	// go-ethereum signs with its own hash, the same signature must be produced over SetCodeAuthorizationHash
+	for _, auth := range []types.SetCodeAuthorization{
+		{Address: common.HexToAddress("0x01")},
+		{ChainID: *uint256.NewInt(1), Address: common.HexToAddress("0x01"), Nonce: 3},
+		{ChainID: *uint256.MustFromHex("0xffffffffffffffffffffffffffffffff"), Address: common.HexToAddress("0xc0de"), Nonce: 1 << 40},
+	} {
+		expected, err := types.SignSetCode(key, auth)
+		a.NoError(err)
+
+		sig, err := crypto.Sign(SetCodeAuthorizationHash(auth).Bytes(), key)
+		a.NoError(err)
+		a.Equal(expected, withSetCodeSignature(auth, sig))
+	}
+}
@@ -0,0 +1,274 @@
+package signers
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/mcuadros/go-defaults"
+	"github.com/openweb3/web3go/interfaces"
+	"github.com/openweb3/web3go/types"
+	"github.com/pkg/errors"
+	"github.com/tyler-smith/go-bip39"
+)
+
+var (
+	ErrWatchOnly     = errors.New("watch-only wallet has no private key")
+	ErrHardenedIndex = errors.New("address index should be less than 2^31")
+	ErrNoMasterKey   = errors.New("no master key to derive custom path")
+)
+
+type HDSignerManagerOption struct {
+	// BaseDerivePath is the derivation path of the account chain, the address of index i is derived at
+	// BaseDerivePath/i. It is only used to derive keys from mnemonic.
+	BaseDerivePath string `default:"m/44'/60'/0'/0"`
+	// Password is the BIP-39 passphrase of mnemonic.
+	Password string `default:""`
+	// GapLimit is the number of consecutive unused addresses to stop scanning.
+	GapLimit uint32 `default:"20"`
+}
+
+func (o *HDSignerManagerOption) setDefault() *HDSignerManagerOption {
+	defaults.SetDefaults(o)
+	return o
+}
+
+// AccountStateReader reads the account state to check whether an address is used, it is implemented by
+// client.RpcEthClient.
+type AccountStateReader interface {
+	TransactionCount(addr common.Address, blockNum *types.BlockNumberOrHash) (*big.Int, error)
+	Balance(addr common.Address, block *types.BlockNumberOrHash) (*big.Int, error)
+}
+
+// HDSignerManager is a SignerManager backed by a HD wallet, signers are derived and added on demand instead of
+// deriving a fixed number of accounts eagerly like NewSignerManagerByMnemonic.
+//
+// HDSignerManager created by an extended public key is watch-only, which generates addresses without private keys.
+type HDSignerManager struct {
+	*SignerManager
+	// master is the master key, it is nil if created by extended key
+	master *hdkeychain.ExtendedKey
+	// account is the extended key of account chain at option.BaseDerivePath, it is neutered if watch-only
+	account *hdkeychain.ExtendedKey
+	option  HDSignerManagerOption
+	mutex   sync.Mutex
+}
+
+// NewHDSignerManagerByMnemonic creates HDSignerManager by BIP-39 mnemonic without any signer, and the addresses
+// derived are the same as NewSignerManagerByMnemonic.
+func NewHDSignerManagerByMnemonic(mnemonic string, option ...HDSignerManagerOption) (*HDSignerManager, error) {
+	opt := getHDSignerManagerOption(option)
+
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, ErrInvalidMnemonic
+	}
+
+	master, err := hdkeychain.NewMaster(bip39.NewSeed(mnemonic, opt.Password), &chaincfg.MainNetParams)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create master key")
+	}
+
+	path, err := accounts.ParseDerivationPath(opt.BaseDerivePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse base derivation path")
+	}
+	account, err := deriveExtendedKey(master, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HDSignerManager{
+		SignerManager: NewSignerManager(nil),
+		master:        master,
+		account:       account,
+		option:        opt,
+	}, nil
+}
+
+// NewHDSignerManagerByExtendedKey creates HDSignerManager by the extended key of account chain, such as the one
+// returned by ExtendedPublicKey. It is watch-only if the key is an extended public key (xpub).
+func NewHDSignerManagerByExtendedKey(key string, option ...HDSignerManagerOption) (*HDSignerManager, error) {
+	account, err := hdkeychain.NewKeyFromString(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse extended key")
+	}
+
+	return &HDSignerManager{
+		SignerManager: NewSignerManager(nil),
+		account:       account,
+		option:        getHDSignerManagerOption(option),
+	}, nil
+}
+
+func getHDSignerManagerOption(option []HDSignerManagerOption) HDSignerManagerOption {
+	var opt HDSignerManagerOption
+	if len(option) > 0 {
+		opt = option[0]
+	}
+	opt.setDefault()
+	return opt
+}
+
+// IsWatchOnly returns whether the manager has no private key.
+func (h *HDSignerManager) IsWatchOnly() bool {
+	return !h.account.IsPrivate()
+}
+
+// ExtendedPublicKey returns the extended public key (xpub) of account chain, which could be used to create a
+// watch-only HDSignerManager.
+func (h *HDSignerManager) ExtendedPublicKey() (string, error) {
+	pub, err := h.account.Neuter()
+	if err != nil {
+		return "", err
+	}
+	return pub.String(), nil
+}
+
+// Address returns the address of index without adding signer, it works in watch-only mode.
+func (h *HDSignerManager) Address(index uint32) (common.Address, error) {
+	key, err := h.deriveIndex(index)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	pubKey, err := key.ECPubKey()
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(*pubKey.ToECDSA()), nil
+}
+
+// Addresses returns count addresses from index start without adding signers.
+func (h *HDSignerManager) Addresses(start, count uint32) ([]common.Address, error) {
+	addresses := make([]common.Address, count)
+	for i := range addresses {
+		addr, err := h.Address(start + uint32(i))
+		if err != nil {
+			return nil, err
+		}
+		addresses[i] = addr
+	}
+	return addresses, nil
+}
+
+// Derive derives the signer of index and adds it to manager, the existing signer is returned if derived already.
+func (h *HDSignerManager) Derive(index uint32) (interfaces.Signer, error) {
+	if h.IsWatchOnly() {
+		return nil, ErrWatchOnly
+	}
+
+	key, err := h.deriveIndex(index)
+	if err != nil {
+		return nil, err
+	}
+	return h.add(key)
+}
+
+// DerivePath derives the signer of a custom derivation path from master key and adds it to manager, it is only
+// supported by HDSignerManager created by mnemonic.
+func (h *HDSignerManager) DerivePath(path string) (interfaces.Signer, error) {
+	if h.master == nil {
+		return nil, ErrNoMasterKey
+	}
+
+	parsed, err := accounts.ParseDerivationPath(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse derivation path")
+	}
+
+	key, err := deriveExtendedKey(h.master, parsed)
+	if err != nil {
+		return nil, err
+	}
+	return h.add(key)
+}
+
+// Scan derives addresses from index 0 and adds signers of used addresses until GapLimit consecutive addresses
+// are unused, an address is used if it has sent transactions or has balance. The used addresses are returned,
+// and no signer is added in watch-only mode.
+func (h *HDSignerManager) Scan(reader AccountStateReader) ([]common.Address, error) {
+	var used []common.Address
+	for index, gap := uint32(0), uint32(0); gap < h.option.GapLimit; index++ {
+		addr, err := h.Address(index)
+		if err != nil {
+			return nil, err
+		}
+
+		isUsed, err := isAddressUsed(reader, addr)
+		if err != nil {
+			return nil, err
+		}
+		if !isUsed {
+			gap++
+			continue
+		}
+
+		gap = 0
+		used = append(used, addr)
+		if !h.IsWatchOnly() {
+			if _, err := h.Derive(index); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return used, nil
+}
+
+func isAddressUsed(reader AccountStateReader, addr common.Address) (bool, error) {
+	nonce, err := reader.TransactionCount(addr, nil)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get transaction count of %v", addr)
+	}
+	if nonce.Sign() > 0 {
+		return true, nil
+	}
+
+	balance, err := reader.Balance(addr, nil)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get balance of %v", addr)
+	}
+	return balance.Sign() > 0, nil
+}
+
+func (h *HDSignerManager) deriveIndex(index uint32) (*hdkeychain.ExtendedKey, error) {
+	if index >= hdkeychain.HardenedKeyStart {
+		return nil, ErrHardenedIndex
+	}
+	return deriveExtendedKey(h.account, accounts.DerivationPath{index})
+}
+
+// add adds the signer of key to manager if absent.
+func (h *HDSignerManager) add(key *hdkeychain.ExtendedKey) (interfaces.Signer, error) {
+	privateKey, err := key.ECPrivKey()
+	if err != nil {
+		return nil, err
+	}
+	signer := NewPrivateKeySigner(privateKey.ToECDSA())
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if existing, err := h.Get(signer.Address()); err == nil {
+		return existing, nil
+	}
+	if err := h.Add(signer); err != nil {
+		return nil, err
+	}
+	// returns the signer wrapped by policy if set
+	return h.Get(signer.Address())
+}
+
+// deriveExtendedKey derives the same as go-ethereum-hdwallet which is used by NewPrivateKeySignerByMnemonic.
+func deriveExtendedKey(key *hdkeychain.ExtendedKey, path accounts.DerivationPath) (*hdkeychain.ExtendedKey, error) {
+	var err error
+	for _, n := range path {
+		if key, err = key.DeriveNonStandard(n); err != nil {
+			return nil, errors.Wrapf(err, "failed to derive path %v", path)
+		}
+	}
+	return key, nil
+}
@@ -0,0 +1,130 @@
+package signers
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/openweb3/web3go/client"
+	"github.com/openweb3/web3go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ AccountStateReader = (*client.RpcEthClient)(nil)
+
+const testMnemonic = "crisp shove million stem shiver side hospital split play lottery join vintage"
+
+// fakeAccountState returns nonce and balance of addresses, others are unused
+type fakeAccountState struct {
+	nonces   map[common.Address]int64
+	balances map[common.Address]int64
+}
+
+func (f *fakeAccountState) TransactionCount(addr common.Address, blockNum *types.BlockNumberOrHash) (*big.Int, error) {
+	return big.NewInt(f.nonces[addr]), nil
+}
+
+func (f *fakeAccountState) Balance(addr common.Address, block *types.BlockNumberOrHash) (*big.Int, error) {
+	return big.NewInt(f.balances[addr]), nil
+}
+
+func TestHDSignerManagerDerive(t *testing.T) {
+	a := assert.New(t)
+	hd, err := NewHDSignerManagerByMnemonic(testMnemonic)
+	a.NoError(err)
+	a.False(hd.IsWatchOnly())
+	a.Empty(hd.List())
+
+	eager := MustNewSignerManagerByMnemonic(testMnemonic, 3, nil)
+	for i, expected := range eager.List() {
+		addr, err := hd.Address(uint32(i))
+		a.NoError(err)
+		a.Equal(expected.Address(), addr)
+	}
+	a.Empty(hd.List())
+
+	signer, err := hd.Derive(2)
+	a.NoError(err)
+	a.Equal(eager.List()[2].Address(), signer.Address())
+	again, err := hd.Derive(2)
+	a.NoError(err)
+	a.Equal(signer, again)
+	a.Len(hd.List(), 1)
+
+	signer, err = hd.DerivePath("m/44'/60'/0'/0/1")
+	a.NoError(err)
+	a.Equal(eager.List()[1].Address(), signer.Address())
+	a.Len(hd.List(), 2)
+
+	_, err = hd.Derive(1 << 31)
+	a.ErrorIs(err, ErrHardenedIndex)
+
+	_, err = NewHDSignerManagerByMnemonic("invalid mnemonic")
+	a.ErrorIs(err, ErrInvalidMnemonic)
+}
+
+func TestHDSignerManagerPolicy(t *testing.T) {
+	a := assert.New(t)
+	hd, err := NewHDSignerManagerByMnemonic(testMnemonic)
+	a.NoError(err)
+	hd.SetPolicy(NewPolicy(PolicyOption{MaxValuePerTx: big.NewInt(100)}))
+
+	// signers are wrapped by policy since derived
+	signer, err := hd.Derive(5)
+	a.NoError(err)
+	to := common.HexToAddress("0x1234")
+	_, err = signer.SignTransaction(ethtypes.NewTx(&ethtypes.LegacyTx{To: &to, Value: big.NewInt(101), GasPrice: big.NewInt(1), Gas: 21000}), big.NewInt(1))
+	a.ErrorIs(err, ErrPolicyViolation)
+}
+
+func TestHDSignerManagerWatchOnly(t *testing.T) {
+	a := assert.New(t)
+	hd, _ := NewHDSignerManagerByMnemonic(testMnemonic)
+
+	xpub, err := hd.ExtendedPublicKey()
+	a.NoError(err)
+	watch, err := NewHDSignerManagerByExtendedKey(xpub)
+	a.NoError(err)
+	a.True(watch.IsWatchOnly())
+
+	expected, _ := hd.Addresses(0, 5)
+	addresses, err := watch.Addresses(0, 5)
+	a.NoError(err)
+	a.Equal(expected, addresses)
+
+	_, err = watch.Derive(0)
+	a.ErrorIs(err, ErrWatchOnly)
+	_, err = watch.DerivePath("m/44'/60'/0'/0/0")
+	a.ErrorIs(err, ErrNoMasterKey)
+}
+
+func TestHDSignerManagerScan(t *testing.T) {
+	a := assert.New(t)
+	hd, _ := NewHDSignerManagerByMnemonic(testMnemonic, HDSignerManagerOption{GapLimit: 3})
+	addresses, _ := hd.Addresses(0, 10)
+
+	// scanning stops at index 5 after 3 consecutive unused addresses, so index 7 is not found
+	state := &fakeAccountState{
+		nonces:   map[common.Address]int64{addresses[0]: 1, addresses[7]: 1},
+		balances: map[common.Address]int64{addresses[2]: 1},
+	}
+
+	used, err := hd.Scan(state)
+	a.NoError(err)
+	a.Equal([]common.Address{addresses[0], addresses[2]}, used)
+	a.Len(hd.List(), 2)
+
+	state.balances[addresses[4]] = 1
+	used, err = hd.Scan(state)
+	a.NoError(err)
+	a.Equal([]common.Address{addresses[0], addresses[2], addresses[4], addresses[7]}, used)
+	a.Len(hd.List(), 4)
+
+	xpub, _ := hd.ExtendedPublicKey()
+	watch, _ := NewHDSignerManagerByExtendedKey(xpub, HDSignerManagerOption{GapLimit: 3})
+	used, err = watch.Scan(state)
+	a.NoError(err)
+	a.Len(used, 4)
+	a.Empty(watch.List())
+}
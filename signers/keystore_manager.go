@@ -0,0 +1,246 @@
+package signers
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/mcuadros/go-defaults"
+	web3types "github.com/openweb3/web3go/types"
+	"github.com/pkg/errors"
+)
+
+type KeystoreManagerOption struct {
+	// ScryptN and ScryptP are the scrypt parameters to encrypt new or imported keys, use keystore.LightScryptN
+	// and keystore.LightScryptP for testing.
+	ScryptN int `default:"262144"`
+	ScryptP int `default:"1"`
+}
+
+func (o *KeystoreManagerOption) setDefault() *KeystoreManagerOption {
+	defaults.SetDefaults(o)
+	return o
+}
+
+// KeystoreManager manages a directory of V3 keystore files. Addresses are read from key files without decrypting,
+// and accounts are unlocked on demand to sign.
+//
+// The directory is watched for added or removed key files, and the signer manager returned by SignerManager is
+// kept in sync with the accounts in directory.
+type KeystoreManager struct {
+	keystore      *keystore.KeyStore
+	signerManager *SignerManager
+	events        chan accounts.WalletEvent
+	sub           event.Subscription
+	mutex         sync.Mutex
+}
+
+// NewKeystoreManager scans the keystore directory and starts watching it, the directory is created when the first
+// key is stored if it does not exist.
+func NewKeystoreManager(dirPath string, option ...KeystoreManagerOption) *KeystoreManager {
+	var opt KeystoreManagerOption
+	if len(option) > 0 {
+		opt = option[0]
+	}
+	opt.setDefault()
+
+	m := &KeystoreManager{
+		keystore:      keystore.NewKeyStore(dirPath, opt.ScryptN, opt.ScryptP),
+		signerManager: NewSignerManager(nil),
+		events:        make(chan accounts.WalletEvent, 16),
+	}
+	m.sub = m.keystore.Subscribe(m.events)
+	m.sync()
+	go m.loop()
+	return m
+}
+
+func (m *KeystoreManager) loop() {
+	for {
+		select {
+		case <-m.events:
+			m.sync()
+		case <-m.sub.Err():
+			return
+		}
+	}
+}
+
+// sync adds signers of new accounts and removes signers of accounts removed from the directory.
+func (m *KeystoreManager) sync() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	current := make(map[common.Address]bool)
+	for _, account := range m.keystore.Accounts() {
+		current[account.Address] = true
+		if _, err := m.signerManager.Get(account.Address); err != nil {
+			m.signerManager.Add(&KeystoreSigner{account: account, keystore: m.keystore})
+		}
+	}
+
+	var removed []common.Address
+	for _, signer := range m.signerManager.List() {
+		if !current[signer.Address()] {
+			removed = append(removed, signer.Address())
+		}
+	}
+	for _, addr := range removed {
+		m.signerManager.Remove(addr)
+	}
+}
+
+// Close stops watching the directory.
+func (m *KeystoreManager) Close() {
+	m.sub.Unsubscribe()
+}
+
+// SignerManager returns the signer manager with signers of all accounts in the directory, signing fails with
+// keystore.ErrLocked if the account is locked.
+func (m *KeystoreManager) SignerManager() *SignerManager {
+	return m.signerManager
+}
+
+// Addresses returns addresses of all accounts in the directory without decrypting key files.
+func (m *KeystoreManager) Addresses() []common.Address {
+	accounts := m.keystore.Accounts()
+	addresses := make([]common.Address, len(accounts))
+	for i, account := range accounts {
+		addresses[i] = account.Address
+	}
+	return addresses
+}
+
+// Has returns whether the account is in the directory.
+func (m *KeystoreManager) Has(address common.Address) bool {
+	return m.keystore.HasAddress(address)
+}
+
+// Signer returns the signer of account, it is able to sign only when the account is unlocked.
+func (m *KeystoreManager) Signer(address common.Address) (*KeystoreSigner, error) {
+	account, err := m.keystore.Find(accounts.Account{Address: address})
+	if err != nil {
+		return nil, err
+	}
+	return &KeystoreSigner{account: account, keystore: m.keystore}, nil
+}
+
+// Unlock unlocks the account until it is locked explicitly.
+func (m *KeystoreManager) Unlock(address common.Address, password string) error {
+	return m.keystore.Unlock(accounts.Account{Address: address}, password)
+}
+
+// TimedUnlock unlocks the account for the duration of timeout, 0 means until it is locked explicitly.
+func (m *KeystoreManager) TimedUnlock(address common.Address, password string, timeout time.Duration) error {
+	return m.keystore.TimedUnlock(accounts.Account{Address: address}, password, timeout)
+}
+
+// Lock removes the decrypted private key of account from memory.
+func (m *KeystoreManager) Lock(address common.Address) error {
+	return m.keystore.Lock(address)
+}
+
+// IsUnlocked returns whether the account is unlocked.
+func (m *KeystoreManager) IsUnlocked(address common.Address) bool {
+	for _, wallet := range m.keystore.Wallets() {
+		if wallet.Accounts()[0].Address == address {
+			status, _ := wallet.Status()
+			return status == "Unlocked"
+		}
+	}
+	return false
+}
+
+// NewAccount generates a random key and stores it encrypted by password.
+func (m *KeystoreManager) NewAccount(password string) (common.Address, error) {
+	account, err := m.keystore.NewAccount(password)
+	if err != nil {
+		return common.Address{}, err
+	}
+	m.sync()
+	return account.Address, nil
+}
+
+// Import stores the keystore json decrypted by password and encrypted by newPassword.
+func (m *KeystoreManager) Import(keyjson []byte, password, newPassword string) (common.Address, error) {
+	account, err := m.keystore.Import(keyjson, password, newPassword)
+	if err != nil {
+		return common.Address{}, err
+	}
+	m.sync()
+	return account.Address, nil
+}
+
+// ImportPrivateKey stores the private key encrypted by password.
+func (m *KeystoreManager) ImportPrivateKey(privateKey *ecdsa.PrivateKey, password string) (common.Address, error) {
+	account, err := m.keystore.ImportECDSA(privateKey, password)
+	if err != nil {
+		return common.Address{}, err
+	}
+	m.sync()
+	return account.Address, nil
+}
+
+// Export returns the keystore json of account decrypted by password and encrypted by newPassword.
+func (m *KeystoreManager) Export(address common.Address, password, newPassword string) ([]byte, error) {
+	return m.keystore.Export(accounts.Account{Address: address}, password, newPassword)
+}
+
+// ChangePassword re-encrypts the key file of account with newPassword.
+func (m *KeystoreManager) ChangePassword(address common.Address, password, newPassword string) error {
+	return m.keystore.Update(accounts.Account{Address: address}, password, newPassword)
+}
+
+// Delete removes the key file of account if the password is correct.
+func (m *KeystoreManager) Delete(address common.Address, password string) error {
+	if err := m.keystore.Delete(accounts.Account{Address: address}, password); err != nil {
+		return err
+	}
+	m.sync()
+	return nil
+}
+
+// KeystoreSigner signs with the key of an account in keystore directory, it fails with keystore.ErrLocked
+// unless the account is unlocked by KeystoreManager.
+type KeystoreSigner struct {
+	account  accounts.Account
+	keystore *keystore.KeyStore
+}
+
+func (k *KeystoreSigner) Address() common.Address {
+	return k.account.Address
+}
+
+func (k *KeystoreSigner) SignTransaction(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return k.keystore.SignTx(k.account, tx, chainID)
+}
+
+func (k *KeystoreSigner) SignMessage(text []byte) ([]byte, error) {
+	return k.keystore.SignHash(k.account, accounts.TextHash(text))
+}
+
+func (k *KeystoreSigner) SignTypedData(typedData web3types.TypedData) ([]byte, error) {
+	hash, err := web3types.TypedDataHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+	return k.SignHash(hash)
+}
+
+func (k *KeystoreSigner) SignHash(hash common.Hash) ([]byte, error) {
+	return k.keystore.SignHash(k.account, hash[:])
+}
+
+func (k *KeystoreSigner) SignSetCodeAuthorization(auth types.SetCodeAuthorization) (types.SetCodeAuthorization, error) {
+	sig, err := k.SignHash(SetCodeAuthorizationHash(auth))
+	if err != nil {
+		return types.SetCodeAuthorization{}, errors.Wrap(err, "failed to sign SetCode authorization")
+	}
+	return withSetCodeSignature(auth, sig), nil
+}
@@ -0,0 +1,114 @@
+package signers
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestKeystoreManager(t *testing.T, dir string) *KeystoreManager {
+	m := NewKeystoreManager(dir, KeystoreManagerOption{ScryptN: keystore.LightScryptN, ScryptP: keystore.LightScryptP})
+	t.Cleanup(m.Close)
+	return m
+}
+
+func TestKeystoreManagerUnlock(t *testing.T) {
+	a := assert.New(t)
+	m := newTestKeystoreManager(t, t.TempDir())
+
+	addr, err := m.NewAccount("foo")
+	a.NoError(err)
+	a.Equal([]common.Address{addr}, m.Addresses())
+	a.True(m.Has(addr))
+	a.False(m.IsUnlocked(addr))
+
+	signer, err := m.SignerManager().Get(addr)
+	a.NoError(err)
+	_, err = signer.SignMessage([]byte("hello"))
+	a.ErrorIs(err, keystore.ErrLocked)
+
+	a.Error(m.Unlock(addr, "bar"))
+	a.NoError(m.Unlock(addr, "foo"))
+	a.True(m.IsUnlocked(addr))
+
+	sig, err := signer.SignMessage([]byte("hello"))
+	a.NoError(err)
+	a.Len(sig, 65)
+
+	tx, err := signer.SignTransaction(types.NewTx(&types.LegacyTx{Nonce: 1, GasPrice: big.NewInt(1), Gas: 21000}), big.NewInt(1))
+	a.NoError(err)
+	sender, _ := types.Sender(types.LatestSignerForChainID(big.NewInt(1)), tx)
+	a.Equal(addr, sender)
+
+	a.NoError(m.Lock(addr))
+	a.False(m.IsUnlocked(addr))
+
+	a.NoError(m.TimedUnlock(addr, "foo", 50*time.Millisecond))
+	a.True(m.IsUnlocked(addr))
+	a.Eventually(func() bool { return !m.IsUnlocked(addr) }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestKeystoreManagerImportExport(t *testing.T) {
+	a := assert.New(t)
+	m := newTestKeystoreManager(t, t.TempDir())
+
+	key, _ := crypto.GenerateKey()
+	addr, err := m.ImportPrivateKey(key, "foo")
+	a.NoError(err)
+	a.Equal(crypto.PubkeyToAddress(key.PublicKey), addr)
+	_, err = m.ImportPrivateKey(key, "foo")
+	a.ErrorIs(err, keystore.ErrAccountAlreadyExists)
+
+	a.NoError(m.ChangePassword(addr, "foo", "bar"))
+	a.Error(m.Unlock(addr, "foo"))
+	a.NoError(m.Unlock(addr, "bar"))
+
+	keyjson, err := m.Export(addr, "bar", "baz")
+	a.NoError(err)
+	signer, err := NewPrivateKeySignerByKeystore(keyjson, "baz")
+	a.NoError(err)
+	a.Equal(addr, signer.Address())
+
+	a.NoError(m.Delete(addr, "bar"))
+	a.Empty(m.Addresses())
+	a.Empty(m.SignerManager().List())
+
+	imported, err := m.Import(keyjson, "baz", "foo")
+	a.NoError(err)
+	a.Equal(addr, imported)
+	a.Len(m.SignerManager().List(), 1)
+}
+
+func TestKeystoreManagerWatch(t *testing.T) {
+	a := assert.New(t)
+	dir := t.TempDir()
+	m := newTestKeystoreManager(t, dir)
+
+	// key files added or removed by others
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	keyjson, err := NewPrivateKeySigner(key).ToKeystore("foo")
+	a.NoError(err)
+	file := filepath.Join(dir, "key.json")
+	a.NoError(os.WriteFile(file, keyjson, 0600))
+
+	a.Eventually(func() bool {
+		_, err := m.SignerManager().Get(addr)
+		return err == nil
+	}, 10*time.Second, 50*time.Millisecond)
+	a.Equal([]common.Address{addr}, m.Addresses())
+
+	a.NoError(os.Remove(file))
+	a.Eventually(func() bool {
+		_, err := m.SignerManager().Get(addr)
+		return err != nil
+	}, 10*time.Second, 50*time.Millisecond)
+}
@@ -0,0 +1,379 @@
+package signers
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/mcuadros/go-defaults"
+	"github.com/openweb3/web3go/interfaces"
+	web3types "github.com/openweb3/web3go/types"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrPolicyViolation = errors.New("signing policy violation")
+)
+
+// SignKind is the kind of signing request evaluated by Policy.
+type SignKind string
+
+const (
+	SignKindTransaction   SignKind = "transaction"
+	SignKindMessage       SignKind = "message"
+	SignKindTypedData     SignKind = "typedData"
+	SignKindAuthorization SignKind = "authorization"
+	SignKindHash          SignKind = "hash"
+)
+
+// PolicyViolationError is returned when a signing request is rejected by Policy, it matches ErrPolicyViolation
+// by errors.Is.
+type PolicyViolationError struct {
+	Address common.Address
+	Kind    SignKind
+	// Rule is the name of PolicyOption field which rejects the request
+	Rule   string
+	Reason string
+}
+
+func (e *PolicyViolationError) Error() string {
+	return fmt.Sprintf("%v: %v of %v rejected by %v, %v", ErrPolicyViolation, e.Kind, e.Address, e.Rule, e.Reason)
+}
+
+func (e *PolicyViolationError) Is(target error) bool {
+	return target == ErrPolicyViolation
+}
+
+// AuditRecord is the record of a signing request passed to PolicyOption.Audit.
+type AuditRecord struct {
+	Time    time.Time
+	Address common.Address
+	Kind    SignKind
+	ChainID *big.Int        // chain id of transaction, typed data domain or authorization
+	To      *common.Address // recipient of transaction or delegate of authorization
+	Value   *big.Int        // value of transaction
+	Cost    *big.Int        // value plus max gas fee of transaction
+	// Err is the PolicyViolationError if rejected, or the error of signer if failed to sign
+	Err error
+}
+
+// PolicyOption configures the rules of Policy, zero values mean no restriction. Values and counts are limited
+// per signer address.
+type PolicyOption struct {
+	// AllowedChainIDs is the allow-list of chain ids of transactions, authorizations and typed data domains.
+	// Authorizations with chain id 0, which are valid on any chain, are rejected unless 0 is allowed. Transactions
+	// signed without chain id and typed data without chainId in domain are rejected if not empty.
+	AllowedChainIDs []uint64
+	// AllowedTo is the allow-list of transaction recipients, contract creation is rejected if not empty.
+	AllowedTo []common.Address
+	// AllowedSelectors is the allow-list of 4 bytes function selectors of transaction data, plain transfers without
+	// data are allowed.
+	AllowedSelectors []hexutil.Bytes
+	// AllowedDelegates is the allow-list of addresses to delegate by EIP-7702 authorizations.
+	AllowedDelegates []common.Address
+	// AllowSignHash allows signing raw hashes, which are rejected by default as they could be any transaction.
+	AllowSignHash bool
+
+	// MaxValuePerTx is the max value of a transaction.
+	MaxValuePerTx *big.Int
+	// MaxValuePerWindow is the max total value of transactions within ValueWindow.
+	MaxValuePerWindow *big.Int
+	ValueWindow       time.Duration `default:"1h"`
+	// DailySpendCap is the max total cost, value plus max gas fee, of transactions within 24 hours.
+	DailySpendCap *big.Int
+
+	// Max counts of signing requests within 24 hours.
+	MaxTransactionsPerDay   int
+	MaxMessagesPerDay       int
+	MaxTypedDataPerDay      int
+	MaxAuthorizationsPerDay int
+
+	// Audit is called with the result of every signing request.
+	Audit func(record AuditRecord)
+}
+
+func (o *PolicyOption) setDefault() *PolicyOption {
+	defaults.SetDefaults(o)
+	return o
+}
+
+// usage is a signing request counted by Policy.
+type usage struct {
+	time  time.Time
+	kind  SignKind
+	value *big.Int
+	cost  *big.Int
+}
+
+// Policy evaluates every signing request of wrapped signers against the rules of PolicyOption.
+type Policy struct {
+	option PolicyOption
+	usages map[common.Address][]*usage
+	mutex  sync.Mutex
+	now    func() time.Time
+}
+
+func NewPolicy(option PolicyOption) *Policy {
+	option.setDefault()
+	return &Policy{
+		option: option,
+		usages: make(map[common.Address][]*usage),
+		now:    time.Now,
+	}
+}
+
+// Wrap returns the signer which evaluates signing requests by the policy before signing.
+func (p *Policy) Wrap(signer interfaces.Signer) interfaces.Signer {
+	if s, ok := signer.(*policySigner); ok && s.policy == p {
+		return s
+	}
+	return &policySigner{signer: signer, policy: p}
+}
+
+// checkChainID checks the chain id of requests bound to a chain, which are rejected if the chain id is absent,
+// such as pre EIP-155 transactions and typed data without chainId in domain.
+func (p *Policy) checkChainID(kind SignKind, chainID *big.Int) (string, string) {
+	if len(p.option.AllowedChainIDs) == 0 || kind == SignKindMessage || kind == SignKindHash {
+		return "", ""
+	}
+	if chainID == nil {
+		return "AllowedChainIDs", "chain id is required"
+	}
+	for _, allowed := range p.option.AllowedChainIDs {
+		if chainID.IsUint64() && chainID.Uint64() == allowed {
+			return "", ""
+		}
+	}
+	return "AllowedChainIDs", fmt.Sprintf("chain id %v is not allowed", chainID)
+}
+
+func (p *Policy) checkTransaction(tx *types.Transaction) (string, string) {
+	if len(p.option.AllowedTo) > 0 {
+		if tx.To() == nil {
+			return "AllowedTo", "contract creation is not allowed"
+		}
+		if !containsAddress(p.option.AllowedTo, *tx.To()) {
+			return "AllowedTo", fmt.Sprintf("recipient %v is not allowed", tx.To())
+		}
+	}
+
+	if len(p.option.AllowedSelectors) > 0 && len(tx.Data()) > 0 {
+		allowed := false
+		for _, selector := range p.option.AllowedSelectors {
+			if len(tx.Data()) >= 4 && bytes.Equal(tx.Data()[:4], selector) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return "AllowedSelectors", fmt.Sprintf("selector %v is not allowed", hexutil.Bytes(tx.Data()[:min(4, len(tx.Data()))]))
+		}
+	}
+
+	if p.option.MaxValuePerTx != nil && tx.Value().Cmp(p.option.MaxValuePerTx) > 0 {
+		return "MaxValuePerTx", fmt.Sprintf("value %v exceeds %v", tx.Value(), p.option.MaxValuePerTx)
+	}
+	return "", ""
+}
+
+// checkUsage checks the limits of values and counts, and records the usage if allowed.
+func (p *Policy) checkUsage(address common.Address, u *usage) (string, string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	// prune usages older than all windows
+	oldest := u.time.Add(-max(p.option.ValueWindow, 24*time.Hour))
+	usages := p.usages[address]
+	for len(usages) > 0 && usages[0].time.Before(oldest) {
+		usages = usages[1:]
+	}
+	p.usages[address] = usages
+
+	count := 0
+	windowValue, dailyCost := new(big.Int).Set(u.value), new(big.Int).Set(u.cost)
+	for _, used := range usages {
+		if used.kind != u.kind {
+			continue
+		}
+		if u.time.Sub(used.time) < 24*time.Hour {
+			count++
+			dailyCost.Add(dailyCost, used.cost)
+		}
+		if u.time.Sub(used.time) < p.option.ValueWindow {
+			windowValue.Add(windowValue, used.value)
+		}
+	}
+
+	if rule, limit := p.dailyCountLimit(u.kind); limit > 0 && count >= limit {
+		return rule, fmt.Sprintf("daily count %v reached", limit)
+	}
+
+	if u.kind == SignKindTransaction {
+		if p.option.MaxValuePerWindow != nil && windowValue.Cmp(p.option.MaxValuePerWindow) > 0 {
+			return "MaxValuePerWindow", fmt.Sprintf("total value %v within %v exceeds %v", windowValue, p.option.ValueWindow, p.option.MaxValuePerWindow)
+		}
+		if p.option.DailySpendCap != nil && dailyCost.Cmp(p.option.DailySpendCap) > 0 {
+			return "DailySpendCap", fmt.Sprintf("total cost %v within 24h exceeds %v", dailyCost, p.option.DailySpendCap)
+		}
+	}
+
+	p.usages[address] = append(usages, u)
+	return "", ""
+}
+
+// dailyCountLimit returns the rule and max count within 24 hours of kind.
+func (p *Policy) dailyCountLimit(kind SignKind) (string, int) {
+	switch kind {
+	case SignKindTransaction:
+		return "MaxTransactionsPerDay", p.option.MaxTransactionsPerDay
+	case SignKindMessage:
+		return "MaxMessagesPerDay", p.option.MaxMessagesPerDay
+	case SignKindTypedData:
+		return "MaxTypedDataPerDay", p.option.MaxTypedDataPerDay
+	case SignKindAuthorization:
+		return "MaxAuthorizationsPerDay", p.option.MaxAuthorizationsPerDay
+	}
+	return "", 0
+}
+
+// release removes the usage of a request failed to sign.
+func (p *Policy) release(address common.Address, u *usage) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	usages := p.usages[address]
+	for i, used := range usages {
+		if used == u {
+			p.usages[address] = append(usages[:i:i], usages[i+1:]...)
+			return
+		}
+	}
+}
+
+// evaluate checks the request by rules, records the usage and calls sign if allowed, then audits the result.
+func (p *Policy) evaluate(record AuditRecord, checks func() (string, string), sign func() error) error {
+	record.Time = p.now()
+	record.Err = p.evaluateAndSign(&record, checks, sign)
+	if p.option.Audit != nil {
+		p.option.Audit(record)
+	}
+	return record.Err
+}
+
+func (p *Policy) evaluateAndSign(record *AuditRecord, checks func() (string, string), sign func() error) error {
+	violation := func(rule, reason string) error {
+		return &PolicyViolationError{Address: record.Address, Kind: record.Kind, Rule: rule, Reason: reason}
+	}
+
+	if record.Kind == SignKindHash && !p.option.AllowSignHash {
+		return violation("AllowSignHash", "signing raw hash is not allowed")
+	}
+	if rule, reason := p.checkChainID(record.Kind, record.ChainID); rule != "" {
+		return violation(rule, reason)
+	}
+	if checks != nil {
+		if rule, reason := checks(); rule != "" {
+			return violation(rule, reason)
+		}
+	}
+
+	u := &usage{time: record.Time, kind: record.Kind, value: new(big.Int), cost: new(big.Int)}
+	if record.Value != nil {
+		u.value.Set(record.Value)
+	}
+	if record.Cost != nil {
+		u.cost.Set(record.Cost)
+	}
+	if rule, reason := p.checkUsage(record.Address, u); rule != "" {
+		return violation(rule, reason)
+	}
+
+	if err := sign(); err != nil {
+		p.release(record.Address, u)
+		return err
+	}
+	return nil
+}
+
+func containsAddress(addresses []common.Address, address common.Address) bool {
+	for _, a := range addresses {
+		if a == address {
+			return true
+		}
+	}
+	return false
+}
+
+// policySigner evaluates signing requests by policy before delegating to signer.
+type policySigner struct {
+	signer interfaces.Signer
+	policy *Policy
+}
+
+func (s *policySigner) Address() common.Address {
+	return s.signer.Address()
+}
+
+func (s *policySigner) SignTransaction(tx *types.Transaction, chainID *big.Int) (signed *types.Transaction, err error) {
+	record := AuditRecord{Address: s.Address(), Kind: SignKindTransaction, ChainID: chainID, To: tx.To(), Value: tx.Value(), Cost: tx.Cost()}
+	err = s.policy.evaluate(record, func() (string, string) {
+		return s.policy.checkTransaction(tx)
+	}, func() error {
+		signed, err = s.signer.SignTransaction(tx, chainID)
+		return err
+	})
+	return signed, err
+}
+
+func (s *policySigner) SignMessage(text []byte) (sig []byte, err error) {
+	record := AuditRecord{Address: s.Address(), Kind: SignKindMessage}
+	err = s.policy.evaluate(record, nil, func() error {
+		sig, err = s.signer.SignMessage(text)
+		return err
+	})
+	return sig, err
+}
+
+func (s *policySigner) SignTypedData(typedData web3types.TypedData) (sig []byte, err error) {
+	record := AuditRecord{Address: s.Address(), Kind: SignKindTypedData}
+	if typedData.Domain.ChainId != nil {
+		record.ChainID = (*big.Int)(typedData.Domain.ChainId)
+	}
+	if typedData.Domain.VerifyingContract != "" {
+		contract := common.HexToAddress(typedData.Domain.VerifyingContract)
+		record.To = &contract
+	}
+	err = s.policy.evaluate(record, nil, func() error {
+		sig, err = s.signer.SignTypedData(typedData)
+		return err
+	})
+	return sig, err
+}
+
+func (s *policySigner) SignHash(hash common.Hash) (sig []byte, err error) {
+	record := AuditRecord{Address: s.Address(), Kind: SignKindHash}
+	err = s.policy.evaluate(record, nil, func() error {
+		sig, err = s.signer.SignHash(hash)
+		return err
+	})
+	return sig, err
+}
+
+func (s *policySigner) SignSetCodeAuthorization(auth types.SetCodeAuthorization) (signed types.SetCodeAuthorization, err error) {
+	record := AuditRecord{Address: s.Address(), Kind: SignKindAuthorization, ChainID: auth.ChainID.ToBig(), To: &auth.Address}
+	err = s.policy.evaluate(record, func() (string, string) {
+		if len(s.policy.option.AllowedDelegates) > 0 && !containsAddress(s.policy.option.AllowedDelegates, auth.Address) {
+			return "AllowedDelegates", fmt.Sprintf("delegate %v is not allowed", auth.Address)
+		}
+		return "", ""
+	}, func() error {
+		signed, err = s.signer.SignSetCodeAuthorization(auth)
+		return err
+	})
+	return signed, err
+}
@@ -0,0 +1,198 @@
+package signers
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+	"github.com/openweb3/web3go/interfaces"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	policyTo      = common.HexToAddress("0x0000000000000000000000000000000000000b0b")
+	policyOther   = common.HexToAddress("0x0000000000000000000000000000000000000c0c")
+	policyChainID = big.NewInt(1)
+)
+
+func newPolicyTx(to *common.Address, value int64, data []byte) *types.Transaction {
+	return types.NewTx(&types.LegacyTx{Nonce: 1, GasPrice: big.NewInt(1), Gas: 100, To: to, Value: big.NewInt(value), Data: data})
+}
+
+// newTestPolicy returns the policy with a fake clock advanced by the returned function.
+func newTestPolicy(option PolicyOption) (*Policy, func(time.Duration)) {
+	p := NewPolicy(option)
+	now := time.Unix(1700000000, 0)
+	p.now = func() time.Time { return now }
+	return p, func(d time.Duration) { now = now.Add(d) }
+}
+
+func assertViolation(a *assert.Assertions, err error, rule string) {
+	var violation *PolicyViolationError
+	if a.True(errors.As(err, &violation), "unexpected error %v", err) {
+		a.Equal(rule, violation.Rule)
+	}
+	a.ErrorIs(err, ErrPolicyViolation)
+}
+
+func TestPolicyTransactionRules(t *testing.T) {
+	a := assert.New(t)
+	p, _ := newTestPolicy(PolicyOption{
+		AllowedChainIDs:  []uint64{1},
+		AllowedTo:        []common.Address{policyTo},
+		AllowedSelectors: []hexutil.Bytes{{0xa9, 0x05, 0x9c, 0xbb}},
+		MaxValuePerTx:    big.NewInt(100),
+	})
+	signer := p.Wrap(MustNewRandomPrivateKeySigner())
+
+	_, err := signer.SignTransaction(newPolicyTx(&policyTo, 100, nil), policyChainID)
+	a.NoError(err)
+	_, err = signer.SignTransaction(newPolicyTx(&policyTo, 0, []byte{0xa9, 0x05, 0x9c, 0xbb, 0x01}), policyChainID)
+	a.NoError(err)
+
+	_, err = signer.SignTransaction(newPolicyTx(&policyTo, 0, nil), big.NewInt(2))
+	assertViolation(a, err, "AllowedChainIDs")
+	_, err = signer.SignTransaction(newPolicyTx(&policyTo, 0, nil), nil)
+	assertViolation(a, err, "AllowedChainIDs")
+
+	typedData := mustParseTypedData(mailTypedData)
+	typedData.Domain.ChainId = nil
+	_, err = signer.SignTypedData(typedData)
+	assertViolation(a, err, "AllowedChainIDs")
+	_, err = signer.SignTransaction(newPolicyTx(&policyOther, 0, nil), policyChainID)
+	assertViolation(a, err, "AllowedTo")
+	_, err = signer.SignTransaction(newPolicyTx(nil, 0, []byte{0x60}), policyChainID)
+	assertViolation(a, err, "AllowedTo")
+	_, err = signer.SignTransaction(newPolicyTx(&policyTo, 0, []byte{0x09, 0x5e, 0xa7, 0xb3}), policyChainID)
+	assertViolation(a, err, "AllowedSelectors")
+	_, err = signer.SignTransaction(newPolicyTx(&policyTo, 0, []byte{0xa9}), policyChainID)
+	assertViolation(a, err, "AllowedSelectors")
+	_, err = signer.SignTransaction(newPolicyTx(&policyTo, 101, nil), policyChainID)
+	assertViolation(a, err, "MaxValuePerTx")
+}
+
+func TestPolicyWindowAndDailyLimits(t *testing.T) {
+	a := assert.New(t)
+	p, advance := newTestPolicy(PolicyOption{
+		MaxValuePerWindow: big.NewInt(100),
+		ValueWindow:       time.Hour,
+		DailySpendCap:     big.NewInt(1000),
+		MaxMessagesPerDay: 1,
+	})
+	signer := p.Wrap(MustNewRandomPrivateKeySigner())
+
+	// cost of transaction is value + 100 gas
+	_, err := signer.SignTransaction(newPolicyTx(&policyTo, 60, nil), policyChainID)
+	a.NoError(err)
+	_, err = signer.SignTransaction(newPolicyTx(&policyTo, 60, nil), policyChainID)
+	assertViolation(a, err, "MaxValuePerWindow")
+
+	for i := 0; i < 4; i++ {
+		advance(time.Hour)
+		_, err = signer.SignTransaction(newPolicyTx(&policyTo, 100, nil), policyChainID)
+		a.NoError(err)
+	}
+	// 5 transactions cost 960 within 24 hours
+	advance(time.Hour)
+	_, err = signer.SignTransaction(newPolicyTx(&policyTo, 0, nil), policyChainID)
+	assertViolation(a, err, "DailySpendCap")
+
+	// the first transaction is out of 24 hours
+	advance(19 * time.Hour)
+	_, err = signer.SignTransaction(newPolicyTx(&policyTo, 0, nil), policyChainID)
+	a.NoError(err)
+
+	// limits are per signer
+	_, err = p.Wrap(MustNewRandomPrivateKeySigner()).SignTransaction(newPolicyTx(&policyTo, 100, nil), policyChainID)
+	a.NoError(err)
+
+	_, err = signer.SignMessage([]byte("hello"))
+	a.NoError(err)
+	_, err = signer.SignMessage([]byte("hello"))
+	assertViolation(a, err, "MaxMessagesPerDay")
+
+	p, _ = newTestPolicy(PolicyOption{MaxTransactionsPerDay: 1})
+	signer = p.Wrap(MustNewRandomPrivateKeySigner())
+	_, err = signer.SignTransaction(newPolicyTx(&policyTo, 0, nil), policyChainID)
+	a.NoError(err)
+	_, err = signer.SignTransaction(newPolicyTx(&policyTo, 0, nil), policyChainID)
+	assertViolation(a, err, "MaxTransactionsPerDay")
+}
+
+// failingSigner fails to sign messages
+type failingSigner struct {
+	*PrivateKeySigner
+}
+
+func (f *failingSigner) SignMessage(text []byte) ([]byte, error) {
+	return nil, errors.New("failed")
+}
+
+func TestPolicyAuditAndRelease(t *testing.T) {
+	a := assert.New(t)
+	var records []AuditRecord
+	p, _ := newTestPolicy(PolicyOption{
+		AllowedChainIDs:   []uint64{1},
+		AllowedDelegates:  []common.Address{policyTo},
+		MaxMessagesPerDay: 1,
+		Audit:             func(record AuditRecord) { records = append(records, record) },
+	})
+	key := MustNewRandomPrivateKeySigner()
+
+	// usage of failed request is released
+	_, err := p.Wrap(&failingSigner{key}).SignMessage([]byte("hello"))
+	a.EqualError(err, "failed")
+	signer := p.Wrap(key)
+	_, err = signer.SignMessage([]byte("hello"))
+	a.NoError(err)
+
+	_, err = signer.SignHash(common.Hash{})
+	assertViolation(a, err, "AllowSignHash")
+
+	_, err = signer.SignSetCodeAuthorization(types.SetCodeAuthorization{ChainID: *uint256.NewInt(1), Address: policyTo})
+	a.NoError(err)
+	_, err = signer.SignSetCodeAuthorization(types.SetCodeAuthorization{ChainID: *uint256.NewInt(0), Address: policyTo})
+	assertViolation(a, err, "AllowedChainIDs")
+	_, err = signer.SignSetCodeAuthorization(types.SetCodeAuthorization{ChainID: *uint256.NewInt(1), Address: policyOther})
+	assertViolation(a, err, "AllowedDelegates")
+
+	typedData := mustParseTypedData(mailTypedData)
+	_, err = signer.SignTypedData(typedData)
+	a.NoError(err)
+
+	a.Len(records, 7)
+	a.Equal(SignKindMessage, records[0].Kind)
+	a.EqualError(records[0].Err, "failed")
+	a.NoError(records[1].Err)
+	a.Equal(key.Address(), records[1].Address)
+	a.Equal(SignKindHash, records[2].Kind)
+	a.ErrorIs(records[2].Err, ErrPolicyViolation)
+	a.Equal(policyTo, *records[3].To)
+	a.Equal(SignKindTypedData, records[6].Kind)
+	a.Equal(int64(1), records[6].ChainID.Int64())
+}
+
+func TestSignerManagerPolicy(t *testing.T) {
+	a := assert.New(t)
+	key := MustNewRandomPrivateKeySigner()
+	sm := NewSignerManager([]interfaces.Signer{key})
+	sm.SetPolicy(NewPolicy(PolicyOption{AllowedChainIDs: []uint64{1}}))
+
+	signer, err := sm.Get(key.Address())
+	a.NoError(err)
+	_, err = signer.SignTransaction(newPolicyTx(&policyTo, 0, nil), big.NewInt(2))
+	a.ErrorIs(err, ErrPolicyViolation)
+
+	_, err = sm.List()[0].SignTransaction(newPolicyTx(&policyTo, 0, nil), big.NewInt(2))
+	a.ErrorIs(err, ErrPolicyViolation)
+
+	sm.SetPolicy(nil)
+	signer, _ = sm.Get(key.Address())
+	_, err = signer.SignTransaction(newPolicyTx(&policyTo, 0, nil), big.NewInt(2))
+	a.NoError(err)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/openweb3/go-sdk-common/privatekeyhelper"
+	web3types "github.com/openweb3/web3go/types"
 	"github.com/pkg/errors"
 )
 
@@ -116,6 +117,14 @@ func (p *PrivateKeySigner) SignMessage(text []byte) ([]byte, error) {
 	return crypto.Sign(hash, p.privateKey)
 }
 
+func (p *PrivateKeySigner) SignTypedData(typedData web3types.TypedData) ([]byte, error) {
+	hash, err := web3types.TypedDataHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+	return p.SignHash(hash)
+}
+
 func (p *PrivateKeySigner) SignHash(hash common.Hash) ([]byte, error) {
 	return crypto.Sign(hash[:], p.privateKey)
 }
@@ -0,0 +1,358 @@
+package signers
+
+import (
+	"context"
+	"crypto/tls"
+	"math/big"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/mcuadros/go-defaults"
+	rpc "github.com/openweb3/go-rpc-provider"
+	providers "github.com/openweb3/go-rpc-provider/interfaces"
+	"github.com/openweb3/web3go/interfaces"
+	web3types "github.com/openweb3/web3go/types"
+	"github.com/pkg/errors"
+	"github.com/valyala/fasthttp"
+)
+
+var (
+	ErrRemoteSignerUnsupported = errors.New("not supported by remote signer")
+	ErrRemoteSignerMismatch    = errors.New("remote signer returned mismatched signature")
+)
+
+// RemoteSignerType is the JSON-RPC api flavour of remote signer.
+type RemoteSignerType int
+
+const (
+	// RemoteSignerClef is the external api of Clef, account_list, account_signTransaction, account_signData
+	// and account_signTypedData are used.
+	RemoteSignerClef RemoteSignerType = iota
+	// RemoteSignerWeb3Signer is the eth1 api of Web3Signer, eth_accounts, eth_signTransaction, eth_sign and
+	// eth_signTypedData are used.
+	RemoteSignerWeb3Signer
+)
+
+type RemoteSignerOption struct {
+	Type RemoteSignerType
+	// TLSConfig is used to connect https endpoint, such as client certificates for mutual TLS or custom root CAs.
+	TLSConfig *tls.Config
+	// Headers are set to each http request, such as "Authorization".
+	Headers map[string]string
+	// Timeout is the max duration of each request, remote signers may wait for manual approval.
+	Timeout time.Duration `default:"30s"`
+}
+
+func (o *RemoteSignerOption) setDefault() *RemoteSignerOption {
+	defaults.SetDefaults(o)
+	return o
+}
+
+type remoteHeadersKey struct{}
+
+var registerRemoteHeaders sync.Once
+
+// RemoteSignerClient is the connection to a remote signer, it discovers accounts and creates RemoteSigner for them.
+type RemoteSignerClient struct {
+	provider providers.Provider
+	option   RemoteSignerOption
+}
+
+// NewRemoteSignerClient dials the remote signer, http(s) endpoints are connected with option.TLSConfig and
+// option.Headers, other endpoints such as ipc and ws are dialed by default.
+func NewRemoteSignerClient(rawurl string, option ...RemoteSignerOption) (*RemoteSignerClient, error) {
+	var opt RemoteSignerOption
+	if len(option) > 0 {
+		opt = option[0]
+	}
+
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse url")
+	}
+
+	var p *rpc.Client
+	switch u.Scheme {
+	case "http", "https":
+		p, err = rpc.DialHTTPWithClient(rawurl, &fasthttp.Client{TLSConfig: opt.TLSConfig})
+	default:
+		p, err = rpc.DialContext(context.Background(), rawurl)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to dial remote signer")
+	}
+
+	return NewRemoteSignerClientWithProvider(p, opt), nil
+}
+
+// NewRemoteSignerClientWithProvider creates RemoteSignerClient on a connected provider.
+func NewRemoteSignerClientWithProvider(provider providers.Provider, option ...RemoteSignerOption) *RemoteSignerClient {
+	var opt RemoteSignerOption
+	if len(option) > 0 {
+		opt = option[0]
+	}
+	opt.setDefault()
+
+	if len(opt.Headers) > 0 {
+		registerRemoteHeaders.Do(func() {
+			rpc.RegisterBeforeSendHttp(setRemoteHeaders)
+		})
+	}
+
+	return &RemoteSignerClient{
+		provider: provider,
+		option:   opt,
+	}
+}
+
+// setRemoteHeaders sets the headers carried by context to the http request of remote signer.
+func setRemoteHeaders(ctx context.Context, req *fasthttp.Request) error {
+	if headers, ok := ctx.Value(remoteHeadersKey{}).(map[string]string); ok {
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+	}
+	return nil
+}
+
+func (c *RemoteSignerClient) call(result interface{}, method string, args ...interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.option.Timeout)
+	defer cancel()
+	if len(c.option.Headers) > 0 {
+		ctx = context.WithValue(ctx, remoteHeadersKey{}, c.option.Headers)
+	}
+	return c.provider.CallContext(ctx, result, method, args...)
+}
+
+// Accounts returns the addresses managed by remote signer.
+func (c *RemoteSignerClient) Accounts() ([]common.Address, error) {
+	method := "account_list"
+	if c.option.Type == RemoteSignerWeb3Signer {
+		method = "eth_accounts"
+	}
+
+	var accounts []common.Address
+	if err := c.call(&accounts, method); err != nil {
+		return nil, errors.Wrap(err, "failed to list accounts")
+	}
+	return accounts, nil
+}
+
+// Signer returns the RemoteSigner of address, the address is not checked against Accounts.
+func (c *RemoteSignerClient) Signer(address common.Address) *RemoteSigner {
+	return &RemoteSigner{
+		address: address,
+		client:  c,
+	}
+}
+
+// Signers returns RemoteSigner of all accounts managed by remote signer.
+func (c *RemoteSignerClient) Signers() ([]interfaces.Signer, error) {
+	accounts, err := c.Accounts()
+	if err != nil {
+		return nil, err
+	}
+
+	signers := make([]interfaces.Signer, len(accounts))
+	for i, account := range accounts {
+		signers[i] = c.Signer(account)
+	}
+	return signers, nil
+}
+
+func (c *RemoteSignerClient) Close() {
+	c.provider.Close()
+}
+
+// NewSignerManagerByRemoteSigner dials the remote signer and creates SignerManager with all accounts it manages.
+func NewSignerManagerByRemoteSigner(rawurl string, option ...RemoteSignerOption) (*SignerManager, error) {
+	c, err := NewRemoteSignerClient(rawurl, option...)
+	if err != nil {
+		return nil, err
+	}
+
+	signers, err := c.Signers()
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+	return NewSignerManager(signers), nil
+}
+
+// RemoteSigner delegates signing to an external signer over JSON-RPC, such as Clef or Web3Signer.
+//
+// Signatures returned by remote signer are verified to be signed by the address. SignHash and
+// SignSetCodeAuthorization are not supported as the remote signer apis don't sign raw hashes.
+type RemoteSigner struct {
+	address common.Address
+	client  *RemoteSignerClient
+}
+
+// NewRemoteSigner dials the remote signer and returns the RemoteSigner of address.
+func NewRemoteSigner(rawurl string, address common.Address, option ...RemoteSignerOption) (*RemoteSigner, error) {
+	c, err := NewRemoteSignerClient(rawurl, option...)
+	if err != nil {
+		return nil, err
+	}
+	return c.Signer(address), nil
+}
+
+func (s *RemoteSigner) Address() common.Address {
+	return s.address
+}
+
+// remoteTxArgs is the transaction arguments of account_signTransaction and eth_signTransaction.
+type remoteTxArgs struct {
+	From                 common.Address    `json:"from"`
+	To                   *common.Address   `json:"to"`
+	Gas                  hexutil.Uint64    `json:"gas"`
+	GasPrice             *hexutil.Big      `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big      `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big      `json:"maxPriorityFeePerGas,omitempty"`
+	Value                hexutil.Big       `json:"value"`
+	Nonce                hexutil.Uint64    `json:"nonce"`
+	Data                 hexutil.Bytes     `json:"data"`
+	AccessList           *types.AccessList `json:"accessList,omitempty"`
+	ChainID              *hexutil.Big      `json:"chainId,omitempty"`
+}
+
+func newRemoteTxArgs(from common.Address, tx *types.Transaction, chainID *big.Int) (*remoteTxArgs, error) {
+	args := &remoteTxArgs{
+		From:    from,
+		To:      tx.To(),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   hexutil.Big(*tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    tx.Data(),
+		ChainID: (*hexutil.Big)(chainID),
+	}
+
+	accessList := tx.AccessList()
+	switch tx.Type() {
+	case types.LegacyTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	case types.AccessListTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+		args.AccessList = &accessList
+	case types.DynamicFeeTxType:
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+		args.AccessList = &accessList
+	default:
+		return nil, errors.Wrapf(ErrRemoteSignerUnsupported, "transaction type %v", tx.Type())
+	}
+	return args, nil
+}
+
+// SignTransaction signs the transaction by account_signTransaction of Clef or eth_signTransaction of Web3Signer,
+// the returned transaction is verified to be the same transaction signed by the address with chainID.
+func (s *RemoteSigner) SignTransaction(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	args, err := newRemoteTxArgs(s.address, tx, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw hexutil.Bytes
+	switch s.client.option.Type {
+	case RemoteSignerWeb3Signer:
+		err = s.client.call(&raw, "eth_signTransaction", args)
+	default:
+		var result struct {
+			Raw hexutil.Bytes `json:"raw"`
+		}
+		err = s.client.call(&result, "account_signTransaction", args)
+		raw = result.Raw
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign transaction")
+	}
+
+	signed := new(types.Transaction)
+	if err := signed.UnmarshalBinary(raw); err != nil {
+		return nil, errors.Wrap(err, "failed to decode signed transaction")
+	}
+
+	signer := types.LatestSignerForChainID(chainID)
+	if signer.Hash(signed) != signer.Hash(tx) {
+		return nil, errors.Wrap(ErrRemoteSignerMismatch, "signed transaction is different")
+	}
+	sender, err := types.Sender(signer, signed)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to recover sender")
+	}
+	if sender != s.address {
+		return nil, errors.Wrapf(ErrRemoteSignerMismatch, "transaction signed by %v", sender)
+	}
+	return signed, nil
+}
+
+// SignMessage signs the message with EIP-191 prefix by account_signData with content type "text/plain" of Clef or
+// eth_sign of Web3Signer. The V of returned signature is 0 or 1 the same as PrivateKeySigner.
+func (s *RemoteSigner) SignMessage(text []byte) ([]byte, error) {
+	var sig hexutil.Bytes
+	var err error
+	switch s.client.option.Type {
+	case RemoteSignerWeb3Signer:
+		err = s.client.call(&sig, "eth_sign", s.address, hexutil.Bytes(text))
+	default:
+		err = s.client.call(&sig, "account_signData", "text/plain", s.address, hexutil.Bytes(text))
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign message")
+	}
+	return s.verify(common.BytesToHash(accounts.TextHash(text)), sig)
+}
+
+// SignTypedData signs the EIP-712 typed data by account_signTypedData of Clef or eth_signTypedData of Web3Signer.
+func (s *RemoteSigner) SignTypedData(typedData web3types.TypedData) ([]byte, error) {
+	hash, err := web3types.TypedDataHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+
+	method := "account_signTypedData"
+	if s.client.option.Type == RemoteSignerWeb3Signer {
+		method = "eth_signTypedData"
+	}
+
+	var sig hexutil.Bytes
+	if err := s.client.call(&sig, method, s.address, typedData); err != nil {
+		return nil, errors.Wrap(err, "failed to sign typed data")
+	}
+	return s.verify(hash, sig)
+}
+
+// verify normalizes V of signature to 0 or 1 and checks the signer of hash is the address.
+func (s *RemoteSigner) verify(hash common.Hash, sig []byte) ([]byte, error) {
+	if len(sig) != crypto.SignatureLength {
+		return nil, errors.Wrapf(ErrRemoteSignerMismatch, "invalid signature length %v", len(sig))
+	}
+
+	sig = common.CopyBytes(sig)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	signer, err := RecoverHash(hash, sig)
+	if err != nil {
+		return nil, err
+	}
+	if signer != s.address {
+		return nil, errors.Wrapf(ErrRemoteSignerMismatch, "signed by %v", signer)
+	}
+	return sig, nil
+}
+
+func (s *RemoteSigner) SignHash(hash common.Hash) ([]byte, error) {
+	return nil, errors.Wrap(ErrRemoteSignerUnsupported, "sign hash")
+}
+
+func (s *RemoteSigner) SignSetCodeAuthorization(auth types.SetCodeAuthorization) (types.SetCodeAuthorization, error) {
+	return types.SetCodeAuthorization{}, errors.Wrap(ErrRemoteSignerUnsupported, "sign SetCode authorization")
+}
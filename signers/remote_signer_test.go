@@ -0,0 +1,211 @@
+package signers
+
+import (
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	rpc "github.com/openweb3/go-rpc-provider"
+	web3types "github.com/openweb3/web3go/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeRemoteSigner signs with private key, it serves both Clef api in namespace "account" and
+// Web3Signer api in namespace "eth".
+type fakeRemoteSigner struct {
+	signer *PrivateKeySigner
+	// tamper makes the signer sign a different transaction
+	tamper bool
+}
+
+func (f *fakeRemoteSigner) signTx(args remoteTxArgs) (hexutil.Bytes, error) {
+	if args.From != f.signer.Address() {
+		return nil, errors.New("unknown account")
+	}
+
+	var tx *types.Transaction
+	if args.MaxFeePerGas != nil {
+		tx = types.NewTx(&types.DynamicFeeTx{
+			ChainID: args.ChainID.ToInt(), Nonce: uint64(args.Nonce), GasTipCap: args.MaxPriorityFeePerGas.ToInt(),
+			GasFeeCap: args.MaxFeePerGas.ToInt(), Gas: uint64(args.Gas), To: args.To, Value: args.Value.ToInt(), Data: args.Data,
+		})
+	} else {
+		nonce := uint64(args.Nonce)
+		if f.tamper {
+			nonce++
+		}
+		tx = types.NewTx(&types.LegacyTx{
+			Nonce: nonce, GasPrice: args.GasPrice.ToInt(), Gas: uint64(args.Gas), To: args.To, Value: args.Value.ToInt(), Data: args.Data,
+		})
+	}
+
+	signed, err := f.signer.SignTransaction(tx, args.ChainID.ToInt())
+	if err != nil {
+		return nil, err
+	}
+	return signed.MarshalBinary()
+}
+
+func (f *fakeRemoteSigner) signText(data hexutil.Bytes) (hexutil.Bytes, error) {
+	sig, err := f.signer.SignMessage(data)
+	if err != nil {
+		return nil, err
+	}
+	sig[64] += 27
+	return sig, nil
+}
+
+func (f *fakeRemoteSigner) signTypedData(typedData web3types.TypedData) (hexutil.Bytes, error) {
+	sig, err := f.signer.SignTypedData(typedData)
+	if err != nil {
+		return nil, err
+	}
+	sig[64] += 27
+	return sig, nil
+}
+
+type fakeClefAPI struct{ *fakeRemoteSigner }
+
+func (api fakeClefAPI) List() []common.Address { return []common.Address{api.signer.Address()} }
+
+func (api fakeClefAPI) SignTransaction(args remoteTxArgs) (map[string]interface{}, error) {
+	raw, err := api.signTx(args)
+	return map[string]interface{}{"raw": raw}, err
+}
+
+func (api fakeClefAPI) SignData(contentType string, addr common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
+	if contentType != "text/plain" {
+		return nil, errors.New("unsupported content type")
+	}
+	return api.signText(data)
+}
+
+func (api fakeClefAPI) SignTypedData(addr common.Address, typedData web3types.TypedData) (hexutil.Bytes, error) {
+	return api.signTypedData(typedData)
+}
+
+type fakeWeb3SignerAPI struct{ *fakeRemoteSigner }
+
+func (api fakeWeb3SignerAPI) Accounts() []common.Address {
+	return []common.Address{api.signer.Address()}
+}
+
+func (api fakeWeb3SignerAPI) SignTransaction(args remoteTxArgs) (hexutil.Bytes, error) {
+	return api.signTx(args)
+}
+
+func (api fakeWeb3SignerAPI) Sign(addr common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
+	return api.signText(data)
+}
+
+func (api fakeWeb3SignerAPI) SignTypedData(addr common.Address, typedData web3types.TypedData) (hexutil.Bytes, error) {
+	return api.signTypedData(typedData)
+}
+
+// newFakeRemoteSigner starts a https server of remote signer requiring the bearer token.
+func newFakeRemoteSigner(t *testing.T) (*fakeRemoteSigner, *httptest.Server) {
+	fake := &fakeRemoteSigner{signer: MustNewPrivateKeySignerByString("0x9a6d3ba2b0c7514b16a006ee605055d71b9edfad183aeb2d9790e9d4ccced471")}
+
+	server := rpc.NewServer()
+	if err := server.RegisterName("account", fakeClefAPI{fake}); err != nil {
+		t.Fatal(err)
+	}
+	if err := server.RegisterName("eth", fakeWeb3SignerAPI{fake}); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(server.Stop)
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		server.ServeHTTP(w, r)
+	}))
+	t.Cleanup(ts.Close)
+	return fake, ts
+}
+
+func newTestRemoteSignerClient(t *testing.T, signerType RemoteSignerType) (*RemoteSignerClient, *fakeRemoteSigner) {
+	fake, ts := newFakeRemoteSigner(t)
+	c, err := NewRemoteSignerClient(ts.URL, RemoteSignerOption{
+		Type:      signerType,
+		TLSConfig: ts.Client().Transport.(*http.Transport).TLSClientConfig,
+		Headers:   map[string]string{"Authorization": "Bearer token"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(c.Close)
+	return c, fake
+}
+
+func TestRemoteSigner(t *testing.T) {
+	a := assert.New(t)
+	for _, signerType := range []RemoteSignerType{RemoteSignerClef, RemoteSignerWeb3Signer} {
+		c, fake := newTestRemoteSignerClient(t, signerType)
+
+		signers, err := c.Signers()
+		a.NoError(err)
+		a.Len(signers, 1)
+		signer := signers[0]
+		a.Equal(fake.signer.Address(), signer.Address())
+
+		to := common.HexToAddress("0x0000000000000000000000000000000000000b0b")
+		chainID := big.NewInt(1030)
+		for _, tx := range []*types.Transaction{
+			types.NewTx(&types.LegacyTx{Nonce: 1, GasPrice: big.NewInt(1), Gas: 21000, To: &to, Value: big.NewInt(100)}),
+			types.NewTx(&types.DynamicFeeTx{ChainID: chainID, Nonce: 2, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(2), Gas: 21000, Data: []byte{0x60}}),
+		} {
+			signed, err := signer.SignTransaction(tx, chainID)
+			a.NoError(err)
+			expected, _ := fake.signer.SignTransaction(tx, chainID)
+			a.Equal(expected.Hash(), signed.Hash())
+		}
+
+		msg := []byte("hello")
+		sig, err := signer.SignMessage(msg)
+		a.NoError(err)
+		expected, _ := fake.signer.SignMessage(msg)
+		a.Equal(expected, sig)
+
+		typedData := mustParseTypedData(mailTypedData)
+		sig, err = signer.SignTypedData(typedData)
+		a.NoError(err)
+		expected, _ = fake.signer.SignTypedData(typedData)
+		a.Equal(expected, sig)
+
+		_, err = signer.SignHash(common.Hash{})
+		a.ErrorIs(err, ErrRemoteSignerUnsupported)
+		_, err = signer.SignSetCodeAuthorization(types.SetCodeAuthorization{})
+		a.ErrorIs(err, ErrRemoteSignerUnsupported)
+	}
+}
+
+func TestRemoteSignerMismatch(t *testing.T) {
+	a := assert.New(t)
+	c, fake := newTestRemoteSignerClient(t, RemoteSignerClef)
+
+	tx := types.NewTx(&types.LegacyTx{Nonce: 1, GasPrice: big.NewInt(1), Gas: 21000})
+	fake.tamper = true
+	_, err := c.Signer(fake.signer.Address()).SignTransaction(tx, big.NewInt(1))
+	a.ErrorIs(err, ErrRemoteSignerMismatch)
+
+	_, err = c.Signer(common.Address{}).SignMessage([]byte("hello"))
+	a.ErrorIs(err, ErrRemoteSignerMismatch)
+
+	tx = types.NewTx(&types.BlobTx{})
+	_, err = c.Signer(fake.signer.Address()).SignTransaction(tx, big.NewInt(1))
+	a.ErrorIs(err, ErrRemoteSignerUnsupported)
+}
+
+func TestRemoteSignerUnauthorized(t *testing.T) {
+	_, ts := newFakeRemoteSigner(t)
+	_, err := NewSignerManagerByRemoteSigner(ts.URL, RemoteSignerOption{TLSConfig: ts.Client().Transport.(*http.Transport).TLSClientConfig})
+	assert.Error(t, err)
+}
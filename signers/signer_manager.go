@@ -9,16 +9,25 @@ import (
 	"github.com/openweb3/web3go/interfaces"
 )
 
+var (
+	ErrSignerNotFound = errors.New("signer not found")
+)
+
+// SignerManager manages signers, it is safe for concurrent use.
 type SignerManager struct {
 	signerMap map[common.Address]interfaces.Signer
 	signers   []interfaces.Signer
-	mutex     sync.Mutex
+	mutex     sync.RWMutex
+	// policy evaluates signing requests of signers returned by Get and List if set
+	policy *Policy
+	// selector selects signer for transactions without from
+	selector SignerSelector
 }
 
 func NewSignerManager(signers []interfaces.Signer) *SignerManager {
 	sm := &SignerManager{
 		signerMap: make(map[common.Address]interfaces.Signer),
-		signers:   signers,
+		signers:   append([]interfaces.Signer{}, signers...),
 	}
 
 	for _, signer := range signers {
@@ -84,14 +93,14 @@ func (s *SignerManager) Remove(addr common.Address) error {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
-	if _, err := s.Get(addr); err != nil {
-		return err
+	if _, ok := s.signerMap[addr]; !ok {
+		return ErrSignerNotFound
 	}
 
 	delete(s.signerMap, addr)
 	for i, signer := range s.signers {
 		if signer.Address() == addr {
-			s.signers = append(s.signers[:i], s.signers[i+1:]...)
+			s.signers = append(s.signers[:i:i], s.signers[i+1:]...)
 			break
 		}
 	}
@@ -99,12 +108,64 @@ func (s *SignerManager) Remove(addr common.Address) error {
 }
 
 func (s *SignerManager) Get(addr common.Address) (interfaces.Signer, error) {
-	if _, ok := s.signerMap[addr]; !ok {
-		return nil, errors.New("signer not found")
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	signer, ok := s.signerMap[addr]
+	if !ok {
+		return nil, ErrSignerNotFound
 	}
-	return s.signerMap[addr], nil
+	return s.wrap(signer), nil
 }
 
+// List returns a snapshot of signers, it is not affected by later Add or Remove.
 func (s *SignerManager) List() []interfaces.Signer {
-	return s.signers
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	signers := make([]interfaces.Signer, len(s.signers))
+	for i, signer := range s.signers {
+		signers[i] = s.wrap(signer)
+	}
+	return signers
+}
+
+// SetPolicy sets the policy to evaluate every signing request of signers returned by Get and List, including
+// transactions signed by SignableMiddleware. Set nil to remove the policy.
+func (s *SignerManager) SetPolicy(policy *Policy) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.policy = policy
+}
+
+// SetSelector sets the strategy to select signer for transactions without from, the first signer is selected
+// by default.
+func (s *SignerManager) SetSelector(selector SignerSelector) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.selector = selector
+}
+
+// Select selects a signer by the selector, it is used by SignableMiddleware to sign transactions without from.
+func (s *SignerManager) Select() (interfaces.Signer, error) {
+	signers := s.List()
+	if len(signers) == 0 {
+		return nil, ErrSignerNotFound
+	}
+
+	s.mutex.RLock()
+	selector := s.selector
+	s.mutex.RUnlock()
+
+	if selector == nil {
+		return signers[0], nil
+	}
+	return selector.Select(signers)
+}
+
+func (s *SignerManager) wrap(signer interfaces.Signer) interfaces.Signer {
+	if s.policy == nil {
+		return signer
+	}
+	return s.policy.Wrap(signer)
 }
@@ -1,9 +1,14 @@
 package signers
 
 import (
+	"errors"
+	"math/big"
+	"sync"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/openweb3/web3go/interfaces"
+	"github.com/openweb3/web3go/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -25,3 +30,98 @@ func TestNewSingerManagerByPrivateKeys(t *testing.T) {
 	a.NoError(err)
 	a.Equal(signer.Address(), common.HexToAddress("0xe6D148D8398c4cb456196C776D2d9093Dd62C9B0"))
 }
+
+func TestSignerManagerConcurrency(t *testing.T) {
+	a := assert.New(t)
+	sm := NewSignerManager(nil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				signer := MustNewRandomPrivateKeySigner()
+				sm.Add(signer)
+				sm.Get(signer.Address())
+				sm.List()
+				sm.Select()
+				sm.Remove(signer.Address())
+			}
+		}()
+	}
+	wg.Wait()
+	a.Empty(sm.List())
+
+	// list is a snapshot
+	signer := MustNewRandomPrivateKeySigner()
+	a.NoError(sm.Add(signer))
+	list := sm.List()
+	a.NoError(sm.Remove(signer.Address()))
+	a.Len(list, 1)
+	a.Equal(signer.Address(), list[0].Address())
+	a.ErrorIs(sm.Remove(signer.Address()), ErrSignerNotFound)
+	_, err := sm.Get(signer.Address())
+	a.ErrorIs(err, ErrSignerNotFound)
+	_, err = sm.Select()
+	a.ErrorIs(err, ErrSignerNotFound)
+}
+
+// pendingAccountState returns the latest nonce and balance of addresses, and the pending nonce with pending
+// transactions counted.
+type pendingAccountState struct {
+	fakeAccountState
+	pending map[common.Address]int64
+}
+
+func (p *pendingAccountState) TransactionCount(addr common.Address, blockNum *types.BlockNumberOrHash) (*big.Int, error) {
+	nonce := p.nonces[addr]
+	if number, ok := blockNum.Number(); ok && number == types.PendingBlockNumber {
+		nonce += p.pending[addr]
+	}
+	return big.NewInt(nonce), nil
+}
+
+func TestSignerManagerSelect(t *testing.T) {
+	a := assert.New(t)
+	sm := MustNewSignerManagerByMnemonic(testMnemonic, 3, nil)
+	list := sm.List()
+
+	// the first signer by default
+	for i := 0; i < 2; i++ {
+		signer, err := sm.Select()
+		a.NoError(err)
+		a.Equal(list[0].Address(), signer.Address())
+	}
+
+	sm.SetSelector(NewRoundRobinSelector())
+	for i := 0; i < 6; i++ {
+		signer, err := sm.Select()
+		a.NoError(err)
+		a.Equal(list[i%3].Address(), signer.Address())
+	}
+
+	state := &pendingAccountState{
+		fakeAccountState: fakeAccountState{
+			nonces:   map[common.Address]int64{list[0].Address(): 10, list[1].Address(): 1, list[2].Address(): 5},
+			balances: map[common.Address]int64{list[0].Address(): 1, list[1].Address(): 3, list[2].Address(): 2},
+		},
+		pending: map[common.Address]int64{list[0].Address(): 2, list[1].Address(): 3, list[2].Address(): 1},
+	}
+
+	sm.SetSelector(NewLeastPendingNonceSelector(state))
+	signer, err := sm.Select()
+	a.NoError(err)
+	a.Equal(list[2].Address(), signer.Address())
+
+	sm.SetSelector(NewHighestBalanceSelector(state))
+	signer, err = sm.Select()
+	a.NoError(err)
+	a.Equal(list[1].Address(), signer.Address())
+
+	sm.SetSelector(SignerSelectorFunc(func(signers []interfaces.Signer) (interfaces.Signer, error) {
+		return nil, errors.New("failed")
+	}))
+	_, err = sm.Select()
+	a.EqualError(err, "failed")
+}
@@ -0,0 +1,100 @@
+package signers
+
+import (
+	"math/big"
+	"sync/atomic"
+
+	"github.com/openweb3/web3go/interfaces"
+	"github.com/openweb3/web3go/types"
+	"github.com/pkg/errors"
+)
+
+// SignerSelector selects a signer from the non-empty snapshot of signers in SignerManager for transactions
+// without from, it is used to share load among a pool of accounts.
+type SignerSelector interface {
+	Select(signers []interfaces.Signer) (interfaces.Signer, error)
+}
+
+// SignerSelectorFunc is an adapter to use a function as SignerSelector.
+type SignerSelectorFunc func(signers []interfaces.Signer) (interfaces.Signer, error)
+
+func (f SignerSelectorFunc) Select(signers []interfaces.Signer) (interfaces.Signer, error) {
+	return f(signers)
+}
+
+// RoundRobinSelector selects signers in turn.
+type RoundRobinSelector struct {
+	next atomic.Uint64
+}
+
+func NewRoundRobinSelector() *RoundRobinSelector {
+	return &RoundRobinSelector{}
+}
+
+func (r *RoundRobinSelector) Select(signers []interfaces.Signer) (interfaces.Signer, error) {
+	index := r.next.Add(1) - 1
+	return signers[index%uint64(len(signers))], nil
+}
+
+// LeastPendingNonceSelector selects the signer with the fewest pending transactions, which is the difference
+// between the pending nonce and latest nonce. The first one is selected if several signers have the same number.
+type LeastPendingNonceSelector struct {
+	reader AccountStateReader
+}
+
+// NewLeastPendingNonceSelector creates a selector reading nonces by reader, such as client.RpcEthClient.
+func NewLeastPendingNonceSelector(reader AccountStateReader) *LeastPendingNonceSelector {
+	return &LeastPendingNonceSelector{reader}
+}
+
+func (l *LeastPendingNonceSelector) Select(signers []interfaces.Signer) (interfaces.Signer, error) {
+	latest := types.BlockNumberOrHashWithNumber(types.LatestBlockNumber)
+	pending := types.BlockNumberOrHashWithNumber(types.PendingBlockNumber)
+
+	var selected interfaces.Signer
+	var least *big.Int
+	for _, signer := range signers {
+		latestNonce, err := l.reader.TransactionCount(signer.Address(), &latest)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "failed to get latest nonce of %v", signer.Address())
+		}
+		pendingNonce, err := l.reader.TransactionCount(signer.Address(), &pending)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "failed to get pending nonce of %v", signer.Address())
+		}
+
+		count := new(big.Int).Sub(pendingNonce, latestNonce)
+		if least == nil || count.Cmp(least) < 0 {
+			selected, least = signer, count
+		}
+	}
+	return selected, nil
+}
+
+// HighestBalanceSelector selects the signer with the highest balance at the latest block. The first one is
+// selected if several signers have the same balance.
+type HighestBalanceSelector struct {
+	reader AccountStateReader
+}
+
+// NewHighestBalanceSelector creates a selector reading balances by reader, such as client.RpcEthClient.
+func NewHighestBalanceSelector(reader AccountStateReader) *HighestBalanceSelector {
+	return &HighestBalanceSelector{reader}
+}
+
+func (h *HighestBalanceSelector) Select(signers []interfaces.Signer) (interfaces.Signer, error) {
+	latest := types.BlockNumberOrHashWithNumber(types.LatestBlockNumber)
+
+	var selected interfaces.Signer
+	var highest *big.Int
+	for _, signer := range signers {
+		balance, err := h.reader.Balance(signer.Address(), &latest)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "failed to get balance of %v", signer.Address())
+		}
+		if highest == nil || balance.Cmp(highest) > 0 {
+			selected, highest = signer, balance
+		}
+	}
+	return selected, nil
+}
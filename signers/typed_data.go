@@ -0,0 +1,44 @@
+package signers
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	web3types "github.com/openweb3/web3go/types"
+	"github.com/pkg/errors"
+)
+
+// RecoverHash returns the address which signed the hash, the recovery id of signature could be 0/1 or 27/28.
+func RecoverHash(hash common.Hash, sig []byte) (common.Address, error) {
+	if len(sig) != crypto.SignatureLength {
+		return common.Address{}, errors.Errorf("invalid signature length %v", len(sig))
+	}
+
+	normalized := common.CopyBytes(sig)
+	if normalized[crypto.RecoveryIDOffset] >= 27 {
+		normalized[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(hash[:], normalized)
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "failed to recover public key")
+	}
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
+
+// RecoverTypedData returns the address which signed the EIP-712 typed data.
+func RecoverTypedData(typedData web3types.TypedData, sig []byte) (common.Address, error) {
+	hash, err := web3types.TypedDataHash(typedData)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return RecoverHash(hash, sig)
+}
+
+// VerifyTypedData checks whether the EIP-712 typed data is signed by address.
+func VerifyTypedData(typedData web3types.TypedData, sig []byte, address common.Address) (bool, error) {
+	signer, err := RecoverTypedData(typedData, sig)
+	if err != nil {
+		return false, err
+	}
+	return signer == address, nil
+}
@@ -0,0 +1,133 @@
+package signers
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	web3types "github.com/openweb3/web3go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// the example of EIP-712 specification
+const mailTypedData = `{
+	"types": {
+		"EIP712Domain": [
+			{"name": "name", "type": "string"},
+			{"name": "version", "type": "string"},
+			{"name": "chainId", "type": "uint256"},
+			{"name": "verifyingContract", "type": "address"}
+		],
+		"Person": [
+			{"name": "name", "type": "string"},
+			{"name": "wallet", "type": "address"}
+		],
+		"Mail": [
+			{"name": "from", "type": "Person"},
+			{"name": "to", "type": "Person"},
+			{"name": "contents", "type": "string"}
+		]
+	},
+	"primaryType": "Mail",
+	"domain": {
+		"name": "Ether Mail",
+		"version": "1",
+		"chainId": 1,
+		"verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"
+	},
+	"message": {
+		"from": {"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+		"to": {"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+		"contents": "Hello, Bob!"
+	}
+}`
+
+// nested structs and arrays
+const groupMailTypedData = `{
+	"types": {
+		"EIP712Domain": [
+			{"name": "name", "type": "string"},
+			{"name": "chainId", "type": "uint256"}
+		],
+		"Person": [
+			{"name": "name", "type": "string"},
+			{"name": "wallets", "type": "address[]"}
+		],
+		"Mail": [
+			{"name": "from", "type": "Person"},
+			{"name": "to", "type": "Person[]"},
+			{"name": "contents", "type": "string"},
+			{"name": "tags", "type": "bytes32[]"}
+		]
+	},
+	"primaryType": "Mail",
+	"domain": {"name": "Group Mail", "chainId": "0x1"},
+	"message": {
+		"from": {"name": "Cow", "wallets": ["0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826", "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF"]},
+		"to": [
+			{"name": "Bob", "wallets": ["0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"]},
+			{"name": "Alice", "wallets": []}
+		],
+		"contents": "Hello, Bob and Alice!",
+		"tags": ["0x0000000000000000000000000000000000000000000000000000000000000001"]
+	}
+}`
+
+func mustParseTypedData(s string) web3types.TypedData {
+	var typedData web3types.TypedData
+	if err := json.Unmarshal([]byte(s), &typedData); err != nil {
+		panic(err)
+	}
+	return typedData
+}
+
+func TestSignTypedData(t *testing.T) {
+	a := assert.New(t)
+	signer := NewPrivateKeySigner(crypto.ToECDSAUnsafe(crypto.Keccak256([]byte("cow"))))
+	typedData := mustParseTypedData(mailTypedData)
+
+	hash, err := web3types.TypedDataHash(typedData)
+	a.NoError(err)
+	a.Equal(common.HexToHash("0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2"), hash)
+
+	sig, err := signer.SignTypedData(typedData)
+	a.NoError(err)
+	a.Equal("0x4355c47d63924e8a72e509b65029052eb6c299d53a04e167c5775fd466751c9d07299936d304c153f6443dfa05f40ff007d72911b6f72307f996231605b9156201", hexutil.Encode(sig))
+
+	recovered, err := RecoverTypedData(typedData, sig)
+	a.NoError(err)
+	a.Equal(signer.Address(), recovered)
+
+	// 27/28 recovery id
+	sig[64] += 27
+	ok, err := VerifyTypedData(typedData, sig, signer.Address())
+	a.NoError(err)
+	a.True(ok)
+
+	typedData.Message["contents"] = "Hello, Alice!"
+	ok, err = VerifyTypedData(typedData, sig, signer.Address())
+	a.NoError(err)
+	a.False(ok)
+}
+
+func TestSignTypedDataNested(t *testing.T) {
+	a := assert.New(t)
+	signer := MustNewRandomPrivateKeySigner()
+	typedData := mustParseTypedData(groupMailTypedData)
+
+	sig, err := signer.SignTypedData(typedData)
+	a.NoError(err)
+
+	ok, err := VerifyTypedData(typedData, sig, signer.Address())
+	a.NoError(err)
+	a.True(ok)
+
+	typedData.PrimaryType = "Unknown"
+	_, err = signer.SignTypedData(typedData)
+	a.Error(err)
+
+	_, err = RecoverHash(common.Hash{}, sig[:64])
+	a.Error(err)
+}
@@ -0,0 +1,84 @@
+package sigverify
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/pkg/errors"
+)
+
+// ERC6492MagicSuffix is the suffix of signatures wrapped by EIP-6492 for not-yet-deployed contract wallets.
+var ERC6492MagicSuffix = hexutil.MustDecode("0x6492649264926492649264926492649264926492649264926492649264926492")
+
+var (
+	ErrInvalidERC6492Signature = errors.New("invalid EIP-6492 signature")
+)
+
+// erc6492Arguments is the ABI encoding of (address factory, bytes factoryCalldata, bytes signature).
+var erc6492Arguments = abi.Arguments{{Type: mustNewType("address")}, {Type: mustNewType("bytes")}, {Type: mustNewType("bytes")}}
+
+// validatorInitCode deploys nothing but returns the output of isValidSignature. It reads arguments appended to
+// the code as 32-byte words factory, signer, length of factory calldata, length of isValidSignature calldata,
+// followed by both calldata. The factory is called with the factory calldata if the signer has no code, then
+// isValidSignature of signer is called and its output is returned, or empty if it fails.
+//
+//	PUSH2 0x0048 DUP1 CODESIZE SUB SWAP1 PUSH1 0 CODECOPY
+//	PUSH1 0x20 MLOAD EXTCODESIZE PUSH1 deployed JUMPI
+//	PUSH1 0 PUSH1 0 PUSH1 0x40 MLOAD PUSH1 0x80 PUSH1 0 PUSH1 0 MLOAD GAS CALL POP
+//	deployed: JUMPDEST
+//	PUSH1 0x20 PUSH1 0 PUSH1 0x60 MLOAD PUSH1 0x40 MLOAD PUSH1 0x80 ADD PUSH1 0 PUSH1 0x20 MLOAD GAS CALL
+//	PUSH1 ok JUMPI PUSH1 0 DUP1 RETURN
+//	ok: JUMPDEST RETURNDATASIZE PUSH1 0 DUP1 RETURNDATACOPY RETURNDATASIZE PUSH1 0 RETURN
+var validatorInitCode = hexutil.MustDecode("0x610048803803906000396020513b60225760006000604051608060006000515af1505b6020600060605160405160800160006020515af1603e57600080f35b3d6000803e3d6000f3")
+
+func mustNewType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
+
+// IsERC6492Signature returns whether the signature is wrapped by EIP-6492.
+func IsERC6492Signature(signature []byte) bool {
+	return len(signature) > len(ERC6492MagicSuffix) && bytes.HasSuffix(signature, ERC6492MagicSuffix)
+}
+
+// WrapERC6492Signature wraps the signature of a not-yet-deployed contract wallet with the factory and calldata
+// to deploy it.
+func WrapERC6492Signature(factory common.Address, factoryCalldata []byte, signature []byte) []byte {
+	encoded, err := erc6492Arguments.Pack(factory, factoryCalldata, signature)
+	if err != nil {
+		// never happens since types of arguments are fixed
+		panic(err)
+	}
+	return append(encoded, ERC6492MagicSuffix...)
+}
+
+// ParseERC6492Signature returns the factory, factory calldata and original signature of the wrapped signature.
+func ParseERC6492Signature(signature []byte) (factory common.Address, factoryCalldata []byte, inner []byte, err error) {
+	if !IsERC6492Signature(signature) {
+		return common.Address{}, nil, nil, errors.Wrap(ErrInvalidERC6492Signature, "magic suffix not found")
+	}
+
+	values, err := erc6492Arguments.Unpack(signature[:len(signature)-len(ERC6492MagicSuffix)])
+	if err != nil {
+		return common.Address{}, nil, nil, errors.Wrap(ErrInvalidERC6492Signature, err.Error())
+	}
+	return values[0].(common.Address), values[1].([]byte), values[2].([]byte), nil
+}
+
+// validatorCode returns the init code to validate the signature by eth_call without to.
+func validatorCode(signer, factory common.Address, factoryCalldata, validateCalldata []byte) []byte {
+	code := append([]byte{}, validatorInitCode...)
+	code = append(code, common.LeftPadBytes(factory.Bytes(), 32)...)
+	code = append(code, common.LeftPadBytes(signer.Bytes(), 32)...)
+	code = append(code, math.U256Bytes(big.NewInt(int64(len(factoryCalldata))))...)
+	code = append(code, math.U256Bytes(big.NewInt(int64(len(validateCalldata))))...)
+	code = append(code, factoryCalldata...)
+	return append(code, validateCalldata...)
+}
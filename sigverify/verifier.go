@@ -0,0 +1,169 @@
+// Package sigverify verifies signatures of externally owned accounts and contract wallets on any node, see
+// EIP-1271 for contract wallets and EIP-6492 for contract wallets not deployed yet.
+package sigverify
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/openweb3/web3go/client"
+	"github.com/openweb3/web3go/types"
+	"github.com/pkg/errors"
+)
+
+// IsValidSignatureABI is the EIP-1271 interface of contract wallets.
+const IsValidSignatureABI = `[{"inputs":[{"internalType":"bytes32","name":"hash","type":"bytes32"},{"internalType":"bytes","name":"signature","type":"bytes"}],"name":"isValidSignature","outputs":[{"internalType":"bytes4","name":"magicValue","type":"bytes4"}],"stateMutability":"view","type":"function"}]`
+
+// EIP1271MagicValue is returned by isValidSignature if the signature is valid.
+var EIP1271MagicValue = [4]byte{0x16, 0x26, 0xba, 0x7e}
+
+var eip1271Abi abi.ABI
+
+func init() {
+	var err error
+	eip1271Abi, err = abi.JSON(strings.NewReader(IsValidSignatureABI))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Method is the way a signature is verified.
+type Method string
+
+const (
+	// MethodECDSA recovers the signer of signature locally.
+	MethodECDSA Method = "ecdsa"
+	// MethodEIP1271 calls isValidSignature of the deployed contract wallet.
+	MethodEIP1271 Method = "eip1271"
+	// MethodEIP6492 calls isValidSignature of the contract wallet after deploying it counterfactually in eth_call.
+	MethodEIP6492 Method = "eip6492"
+)
+
+// Result is the result of signature verification.
+type Result struct {
+	Valid  bool
+	Method Method
+	// Recovered is the recovered signer if the signature is verified by ECDSA, it is the zero address if the
+	// signature is malformed.
+	Recovered common.Address
+	// Reason describes why the signature is invalid.
+	Reason string
+}
+
+// Verifier verifies signatures by standard eth RPC methods, so it works on any node unlike the Parity-only
+// RpcParityClient.VerifySignature.
+//
+// Wrapped EIP-6492 signatures are verified by eth_call of a validator which deploys the contract wallet by the
+// factory first. Other signatures are recovered locally, and verified by isValidSignature through eth_call if
+// the signer is not recovered and has code.
+type Verifier struct {
+	eth *client.RpcEthClient
+}
+
+// NewVerifier creates a verifier, eth could be nil to verify signatures of externally owned accounts only.
+func NewVerifier(eth *client.RpcEthClient) *Verifier {
+	return &Verifier{eth}
+}
+
+// VerifyMessage verifies the EIP-191 personal signature of message, as signed by interfaces.Signer.SignMessage.
+func (v *Verifier) VerifyMessage(signer common.Address, message []byte, signature []byte) (*Result, error) {
+	return v.VerifyHash(signer, common.BytesToHash(accounts.TextHash(message)), signature)
+}
+
+// VerifyTypedData verifies the EIP-712 signature of typed data, as signed by interfaces.Signer.SignTypedData.
+func (v *Verifier) VerifyTypedData(signer common.Address, typedData types.TypedData, signature []byte) (*Result, error) {
+	hash, err := types.TypedDataHash(typedData)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to hash typed data")
+	}
+	return v.VerifyHash(signer, hash, signature)
+}
+
+// VerifyHash verifies the signature of hash by signer. Error is returned only if it fails to request the node,
+// invalid signatures are reported by Result.
+func (v *Verifier) VerifyHash(signer common.Address, hash common.Hash, signature []byte) (*Result, error) {
+	if IsERC6492Signature(signature) {
+		return v.verifyERC6492(signer, hash, signature)
+	}
+
+	recovered := RecoverAddress(hash, signature)
+	if recovered == signer {
+		return &Result{Valid: true, Method: MethodECDSA, Recovered: recovered}, nil
+	}
+
+	invalid := &Result{Method: MethodECDSA, Recovered: recovered, Reason: "recovered signer mismatch"}
+	if v.eth == nil {
+		return invalid, nil
+	}
+
+	code, err := v.eth.CodeAt(signer, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get code")
+	}
+	if len(code) == 0 {
+		return invalid, nil
+	}
+
+	data, err := eip1271Abi.Pack("isValidSignature", hash, signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to pack isValidSignature")
+	}
+	output, err := v.eth.Call(types.CallRequest{To: &signer, Data: data}, nil, nil, nil)
+	if err != nil {
+		// contract wallets may revert on invalid signatures
+		return &Result{Method: MethodEIP1271, Reason: "isValidSignature failed: " + err.Error()}, nil
+	}
+	return eip1271Result(MethodEIP1271, output), nil
+}
+
+func (v *Verifier) verifyERC6492(signer common.Address, hash common.Hash, signature []byte) (*Result, error) {
+	if v.eth == nil {
+		return &Result{Method: MethodEIP6492, Reason: "eth client is required"}, nil
+	}
+
+	factory, factoryCalldata, inner, err := ParseERC6492Signature(signature)
+	if err != nil {
+		return &Result{Method: MethodEIP6492, Reason: err.Error()}, nil
+	}
+
+	data, err := eip1271Abi.Pack("isValidSignature", hash, inner)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to pack isValidSignature")
+	}
+	output, err := v.eth.Call(types.CallRequest{Data: validatorCode(signer, factory, factoryCalldata, data)}, nil, nil, nil)
+	if err != nil {
+		return &Result{Method: MethodEIP6492, Reason: "validation failed: " + err.Error()}, nil
+	}
+	return eip1271Result(MethodEIP6492, output), nil
+}
+
+func eip1271Result(method Method, output []byte) *Result {
+	if len(output) < 4 || !bytes.Equal(output[:4], EIP1271MagicValue[:]) {
+		return &Result{Method: method, Reason: "isValidSignature returned no magic value"}
+	}
+	return &Result{Valid: true, Method: method}
+}
+
+// RecoverAddress returns the signer of hash, or the zero address if the signature is malformed. The recovery id
+// of signature could be 0/1 or 27/28.
+func RecoverAddress(hash common.Hash, signature []byte) common.Address {
+	if len(signature) != crypto.SignatureLength {
+		return common.Address{}
+	}
+
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig, signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pubkey, err := crypto.SigToPub(hash[:], sig)
+	if err != nil {
+		return common.Address{}
+	}
+	return crypto.PubkeyToAddress(*pubkey)
+}
@@ -0,0 +1,185 @@
+package sigverify
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/ethereum/go-ethereum/crypto"
+	rpc "github.com/openweb3/go-rpc-provider"
+	"github.com/openweb3/web3go/client"
+	"github.com/openweb3/web3go/signers"
+	"github.com/openweb3/web3go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	factory  = common.HexToAddress("0x000000000000000000000000000000000000fac7")
+	deployed = common.HexToAddress("0x000000000000000000000000000000000000a11c")
+	// secret is the first word of signatures accepted by wallet
+	secret = common.HexToHash("0x5ec7e75ec7e75ec7e75ec7e75ec7e75ec7e75ec7e75ec7e75ec7e75ec7e75ec7")
+)
+
+// walletRuntimeCode returns the magic value if the first word of signature is secret, otherwise reverts.
+func walletRuntimeCode() []byte {
+	code := []byte{0x60, 0x64, 0x35, 0x7f}
+	code = append(code, secret.Bytes()...)
+	code = append(code, 0x14, 0x60, 0x2c, 0x57, 0x60, 0x00, 0x80, 0xfd, 0x5b, 0x63)
+	code = append(code, EIP1271MagicValue[:]...)
+	return append(code, 0x60, 0xe0, 0x1b, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3)
+}
+
+// walletInitCode deploys walletRuntimeCode.
+func walletInitCode() []byte {
+	runtimeCode := walletRuntimeCode()
+	code := []byte{0x60, byte(len(runtimeCode)), 0x80, 0x60, 0x0b, 0x60, 0x00, 0x39, 0x60, 0x00, 0xf3}
+	return append(code, runtimeCode...)
+}
+
+// factoryRuntimeCode deploys calldata as init code by CREATE2 with zero salt.
+var factoryRuntimeCode = hexutil.MustDecode("0x36600060003760003660006000f500")
+
+// evmChain executes eth_call on an in-memory state with the go-ethereum EVM, state changes are discarded.
+type evmChain struct {
+	state *state.StateDB
+	calls int
+}
+
+func newEvmChain() *evmChain {
+	statedb, _ := state.New(ethtypes.EmptyRootHash, state.NewDatabaseForTesting())
+	statedb.SetCode(factory, factoryRuntimeCode)
+	statedb.SetCode(deployed, walletRuntimeCode())
+	return &evmChain{state: statedb}
+}
+
+func (c *evmChain) GetCode(addr common.Address, block *types.BlockNumberOrHash) hexutil.Bytes {
+	return c.state.GetCode(addr)
+}
+
+func (c *evmChain) Call(req types.CallRequest, block *types.BlockNumberOrHash, overrides *types.StateOverride, blockOverrides *types.BlockOverrides) (hexutil.Bytes, error) {
+	c.calls++
+	cfg := &runtime.Config{State: c.state.Copy(), GasLimit: math.MaxUint64 / 2}
+	if req.To == nil {
+		output, _, _, err := runtime.Create(req.Data, cfg)
+		return output, err
+	}
+	output, _, err := runtime.Call(*req.To, req.Data, cfg)
+	return output, err
+}
+
+func newTestVerifier(t *testing.T) (*Verifier, *evmChain) {
+	chain := newEvmChain()
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", chain); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(server.Stop)
+	return NewVerifier(client.NewRpcEthClient(rpc.DialInProc(server))), chain
+}
+
+func walletSignature() []byte {
+	return append(secret.Bytes(), make([]byte, 33)...)
+}
+
+func TestVerifyECDSA(t *testing.T) {
+	a := assert.New(t)
+	v, chain := newTestVerifier(t)
+	signer := signers.MustNewRandomPrivateKeySigner()
+
+	sig, err := signer.SignMessage([]byte("hello"))
+	a.NoError(err)
+	result, err := v.VerifyMessage(signer.Address(), []byte("hello"), sig)
+	a.NoError(err)
+	a.Equal(&Result{Valid: true, Method: MethodECDSA, Recovered: signer.Address()}, result)
+	a.Equal(0, chain.calls)
+
+	typedData := types.TypedData{
+		Types: types.TypedDataTypes{
+			"EIP712Domain": {{Name: "name", Type: "string"}},
+			"Mail":         {{Name: "contents", Type: "string"}},
+		},
+		PrimaryType: "Mail",
+		Domain:      types.TypedDataDomain{Name: "test"},
+		Message:     types.TypedDataMessage{"contents": "hello"},
+	}
+	sig, err = signer.SignTypedData(typedData)
+	a.NoError(err)
+	result, err = NewVerifier(nil).VerifyTypedData(signer.Address(), typedData, sig)
+	a.NoError(err)
+	a.True(result.Valid)
+
+	other := signers.MustNewRandomPrivateKeySigner().Address()
+	result, err = v.VerifyTypedData(other, typedData, sig)
+	a.NoError(err)
+	a.False(result.Valid)
+	a.Equal(MethodECDSA, result.Method)
+	a.Equal(signer.Address(), result.Recovered)
+
+	result, err = v.VerifyMessage(signer.Address(), []byte("hello"), sig[:64])
+	a.NoError(err)
+	a.False(result.Valid)
+	a.Equal(common.Address{}, result.Recovered)
+}
+
+func TestVerifyEIP1271(t *testing.T) {
+	a := assert.New(t)
+	v, _ := newTestVerifier(t)
+	hash := common.HexToHash("0x01")
+
+	result, err := v.VerifyHash(deployed, hash, walletSignature())
+	a.NoError(err)
+	a.Equal(&Result{Valid: true, Method: MethodEIP1271}, result)
+
+	result, err = v.VerifyHash(deployed, hash, make([]byte, 65))
+	a.NoError(err)
+	a.False(result.Valid)
+	a.Equal(MethodEIP1271, result.Method)
+
+	// signature of wallet is unable to be verified without eth client
+	result, err = NewVerifier(nil).VerifyHash(deployed, hash, walletSignature())
+	a.NoError(err)
+	a.False(result.Valid)
+}
+
+func TestVerifyEIP6492(t *testing.T) {
+	a := assert.New(t)
+	v, chain := newTestVerifier(t)
+	hash := common.HexToHash("0x01")
+	wallet := crypto.CreateAddress2(factory, common.Hash{}, crypto.Keccak256(walletInitCode()))
+
+	wrapped := WrapERC6492Signature(factory, walletInitCode(), walletSignature())
+	a.True(IsERC6492Signature(wrapped))
+	parsedFactory, calldata, inner, err := ParseERC6492Signature(wrapped)
+	a.NoError(err)
+	a.Equal(factory, parsedFactory)
+	a.Equal(walletInitCode(), calldata)
+	a.Equal(walletSignature(), inner)
+
+	result, err := v.VerifyHash(wallet, hash, wrapped)
+	a.NoError(err)
+	a.Equal(&Result{Valid: true, Method: MethodEIP6492}, result)
+	// the wallet is deployed in eth_call only
+	a.Empty(chain.state.GetCode(wallet))
+
+	result, err = v.VerifyHash(wallet, hash, WrapERC6492Signature(factory, walletInitCode(), make([]byte, 65)))
+	a.NoError(err)
+	a.False(result.Valid)
+	a.Equal(MethodEIP6492, result.Method)
+
+	// wrong factory calldata deploys nothing
+	result, err = v.VerifyHash(wallet, hash, WrapERC6492Signature(factory, nil, walletSignature()))
+	a.NoError(err)
+	a.False(result.Valid)
+
+	// deployed wallet is verified without deploying again
+	result, err = v.VerifyHash(deployed, hash, WrapERC6492Signature(factory, nil, walletSignature()))
+	a.NoError(err)
+	a.True(result.Valid)
+
+	_, _, _, err = ParseERC6492Signature(walletSignature())
+	a.ErrorIs(err, ErrInvalidERC6492Signature)
+}
@@ -0,0 +1,316 @@
+// Package siwe builds, parses, signs and verifies Sign-In with Ethereum (EIP-4361) messages.
+package siwe
+
+import (
+	"crypto/rand"
+	"math/big"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/openweb3/web3go/interfaces"
+	"github.com/pkg/errors"
+)
+
+const (
+	headerSuffix = " wants you to sign in with your Ethereum account:"
+
+	tagURI            = "URI: "
+	tagVersion        = "Version: "
+	tagChainID        = "Chain ID: "
+	tagNonce          = "Nonce: "
+	tagIssuedAt       = "Issued At: "
+	tagExpirationTime = "Expiration Time: "
+	tagNotBefore      = "Not Before: "
+	tagRequestID      = "Request ID: "
+	tagResources      = "Resources:"
+
+	nonceAlphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+)
+
+var (
+	ErrInvalidMessage = errors.New("invalid SIWE message")
+)
+
+// Message is a Sign-In with Ethereum message, see https://eips.ethereum.org/EIPS/eip-4361.
+type Message struct {
+	// Scheme is the optional URI scheme of the origin of request, such as https.
+	Scheme string
+	// Domain is the RFC 3986 authority that is requesting the signing.
+	Domain  string
+	Address common.Address
+	// Statement is the optional human-readable assertion that the user signs, it must not contain newlines.
+	Statement string
+	// URI is the RFC 3986 URI referring to the resource that is the subject of the signing.
+	URI string
+	// Version is the version of message, it must be "1".
+	Version string
+	ChainID uint64
+	// Nonce is a random string of at least 8 alphanumeric characters to prevent replay attacks, see GenerateNonce.
+	Nonce    string
+	IssuedAt time.Time
+	// ExpirationTime is the optional time after which the signed message is no longer valid.
+	ExpirationTime *time.Time
+	// NotBefore is the optional time before which the signed message is not yet valid.
+	NotBefore *time.Time
+	// RequestID is an optional system-specific identifier of the sign-in request.
+	RequestID string
+	// Resources is an optional list of RFC 3986 URIs the user wishes to have resolved as part of authentication.
+	Resources []string
+}
+
+// NewMessage creates a message of version 1 issued now.
+func NewMessage(domain string, address common.Address, uri string, chainID uint64, nonce string) *Message {
+	return &Message{
+		Domain:   domain,
+		Address:  address,
+		URI:      uri,
+		Version:  "1",
+		ChainID:  chainID,
+		Nonce:    nonce,
+		IssuedAt: time.Now().UTC(),
+	}
+}
+
+// GenerateNonce returns a random alphanumeric nonce of 17 characters.
+func GenerateNonce() (string, error) {
+	max := big.NewInt(int64(len(nonceAlphabet)))
+	nonce := make([]byte, 17)
+	for i := range nonce {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to generate random number")
+		}
+		nonce[i] = nonceAlphabet[n.Int64()]
+	}
+	return string(nonce), nil
+}
+
+// Validate checks fields of the message are well-formed.
+func (m *Message) Validate() error {
+	if m.Scheme != "" && !isScheme(m.Scheme) {
+		return errors.Wrapf(ErrInvalidMessage, "invalid scheme %q", m.Scheme)
+	}
+	if m.Domain == "" || strings.ContainsAny(m.Domain, " \n/") {
+		return errors.Wrapf(ErrInvalidMessage, "invalid domain %q", m.Domain)
+	}
+	if strings.Contains(m.Statement, "\n") {
+		return errors.Wrap(ErrInvalidMessage, "statement contains newline")
+	}
+	if !isURI(m.URI) {
+		return errors.Wrapf(ErrInvalidMessage, "invalid URI %q", m.URI)
+	}
+	if m.Version != "1" {
+		return errors.Wrapf(ErrInvalidMessage, "unsupported version %q", m.Version)
+	}
+	if len(m.Nonce) < 8 || strings.Trim(m.Nonce, nonceAlphabet) != "" {
+		return errors.Wrapf(ErrInvalidMessage, "nonce %q is not at least 8 alphanumeric characters", m.Nonce)
+	}
+	if m.IssuedAt.IsZero() {
+		return errors.Wrap(ErrInvalidMessage, "issued at is required")
+	}
+	if strings.Contains(m.RequestID, "\n") {
+		return errors.Wrap(ErrInvalidMessage, "request ID contains newline")
+	}
+	for _, resource := range m.Resources {
+		if !isURI(resource) {
+			return errors.Wrapf(ErrInvalidMessage, "invalid resource %q", resource)
+		}
+	}
+	return nil
+}
+
+// String returns the canonical text of message to sign.
+func (m *Message) String() string {
+	var b strings.Builder
+
+	if m.Scheme != "" {
+		b.WriteString(m.Scheme + "://")
+	}
+	b.WriteString(m.Domain + headerSuffix + "\n")
+	b.WriteString(m.Address.Hex() + "\n\n")
+	if m.Statement != "" {
+		b.WriteString(m.Statement + "\n")
+	}
+	b.WriteString("\n")
+
+	b.WriteString(tagURI + m.URI + "\n")
+	b.WriteString(tagVersion + m.Version + "\n")
+	b.WriteString(tagChainID + strconv.FormatUint(m.ChainID, 10) + "\n")
+	b.WriteString(tagNonce + m.Nonce + "\n")
+	b.WriteString(tagIssuedAt + formatTime(m.IssuedAt))
+	if m.ExpirationTime != nil {
+		b.WriteString("\n" + tagExpirationTime + formatTime(*m.ExpirationTime))
+	}
+	if m.NotBefore != nil {
+		b.WriteString("\n" + tagNotBefore + formatTime(*m.NotBefore))
+	}
+	if m.RequestID != "" {
+		b.WriteString("\n" + tagRequestID + m.RequestID)
+	}
+	if len(m.Resources) > 0 {
+		b.WriteString("\n" + tagResources)
+		for _, resource := range m.Resources {
+			b.WriteString("\n- " + resource)
+		}
+	}
+
+	return b.String()
+}
+
+// Sign validates the message and signs its canonical text by signer with the EIP-191 prefix, the signer must
+// be the one of message address.
+func (m *Message) Sign(signer interfaces.Signer) ([]byte, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	if signer.Address() != m.Address {
+		return nil, errors.Errorf("signer %v is not the message address %v", signer.Address(), m.Address)
+	}
+	return signer.SignMessage([]byte(m.String()))
+}
+
+// ParseMessage parses the canonical text of message. Note the signature should be verified against the
+// original text instead of the String of parsed message, because times may be formatted differently.
+func ParseMessage(text string) (*Message, error) {
+	p := &parser{lines: strings.Split(text, "\n")}
+	m := &Message{}
+
+	header := p.next()
+	if !strings.HasSuffix(header, headerSuffix) {
+		return nil, p.errorf("invalid header")
+	}
+	m.Domain = strings.TrimSuffix(header, headerSuffix)
+	if scheme, domain, ok := strings.Cut(m.Domain, "://"); ok {
+		m.Scheme, m.Domain = scheme, domain
+	}
+
+	address := p.next()
+	if !common.IsHexAddress(address) || common.HexToAddress(address).Hex() != address {
+		return nil, p.errorf("address %q is not EIP-55 checksummed", address)
+	}
+	m.Address = common.HexToAddress(address)
+
+	if p.next() != "" {
+		return nil, p.errorf("expect empty line")
+	}
+	if m.Statement = p.next(); m.Statement != "" {
+		if p.next() != "" {
+			return nil, p.errorf("expect empty line")
+		}
+	}
+
+	var ok bool
+	if m.URI, ok = p.tag(tagURI); !ok {
+		return nil, p.errorf("URI is required")
+	}
+	if m.Version, ok = p.tag(tagVersion); !ok {
+		return nil, p.errorf("version is required")
+	}
+	chainID, ok := p.tag(tagChainID)
+	if !ok {
+		return nil, p.errorf("chain ID is required")
+	}
+	var err error
+	if m.ChainID, err = strconv.ParseUint(chainID, 10, 64); err != nil {
+		return nil, p.errorf("invalid chain ID %q", chainID)
+	}
+	if m.Nonce, ok = p.tag(tagNonce); !ok {
+		return nil, p.errorf("nonce is required")
+	}
+	issuedAt, ok := p.tag(tagIssuedAt)
+	if !ok {
+		return nil, p.errorf("issued at is required")
+	}
+	if m.IssuedAt, err = parseTime(issuedAt); err != nil {
+		return nil, p.errorf("invalid issued at %q", issuedAt)
+	}
+	if value, ok := p.tag(tagExpirationTime); ok {
+		t, err := parseTime(value)
+		if err != nil {
+			return nil, p.errorf("invalid expiration time %q", value)
+		}
+		m.ExpirationTime = &t
+	}
+	if value, ok := p.tag(tagNotBefore); ok {
+		t, err := parseTime(value)
+		if err != nil {
+			return nil, p.errorf("invalid not before %q", value)
+		}
+		m.NotBefore = &t
+	}
+	m.RequestID, _ = p.tag(tagRequestID)
+	if p.peek() == tagResources {
+		p.next()
+		for p.hasNext() {
+			resource, ok := p.tag("- ")
+			if !ok {
+				return nil, p.errorf("invalid resource")
+			}
+			m.Resources = append(m.Resources, resource)
+		}
+	}
+	if p.hasNext() {
+		return nil, p.errorf("unexpected line %q", p.peek())
+	}
+
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// parser reads lines of message.
+type parser struct {
+	lines []string
+	index int
+}
+
+func (p *parser) hasNext() bool {
+	return p.index < len(p.lines)
+}
+
+func (p *parser) peek() string {
+	if !p.hasNext() {
+		return ""
+	}
+	return p.lines[p.index]
+}
+
+func (p *parser) next() string {
+	line := p.peek()
+	p.index++
+	return line
+}
+
+// tag returns the value of the line and moves to next line if the line starts with tag.
+func (p *parser) tag(tag string) (string, bool) {
+	if !p.hasNext() || !strings.HasPrefix(p.peek(), tag) {
+		return "", false
+	}
+	return strings.TrimPrefix(p.next(), tag), true
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return errors.Wrapf(ErrInvalidMessage, "line %v: "+format, append([]interface{}{p.index}, args...)...)
+}
+
+func formatTime(t time.Time) string {
+	return t.Format(time.RFC3339Nano)
+}
+
+func parseTime(value string) (time.Time, error) {
+	return time.Parse(time.RFC3339Nano, value)
+}
+
+func isScheme(scheme string) bool {
+	u, err := url.Parse(scheme + "://")
+	return err == nil && u.Scheme == strings.ToLower(scheme)
+}
+
+func isURI(uri string) bool {
+	u, err := url.Parse(uri)
+	return err == nil && u.Scheme != "" && !strings.ContainsAny(uri, " \n")
+}
@@ -0,0 +1,107 @@
+package siwe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleMessage = `service.invalid wants you to sign in with your Ethereum account:
+0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2
+
+I accept the ServiceOrg Terms of Service: https://service.invalid/tos
+
+URI: https://service.invalid/login
+Version: 1
+Chain ID: 1
+Nonce: 32891756
+Issued At: 2021-09-30T16:25:24Z
+Resources:
+- ipfs://bafybeiemxf5abjwjbikoz4mc3a3dla6ual3jsgpdr4cjr3oz3evfyavhwq/
+- https://example.com/my-web2-claim.json`
+
+func TestParseMessage(t *testing.T) {
+	a := assert.New(t)
+
+	m, err := ParseMessage(exampleMessage)
+	a.NoError(err)
+	a.Equal("", m.Scheme)
+	a.Equal("service.invalid", m.Domain)
+	a.Equal(common.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2"), m.Address)
+	a.Equal("I accept the ServiceOrg Terms of Service: https://service.invalid/tos", m.Statement)
+	a.Equal("https://service.invalid/login", m.URI)
+	a.Equal(uint64(1), m.ChainID)
+	a.Equal("32891756", m.Nonce)
+	a.Equal(time.Date(2021, 9, 30, 16, 25, 24, 0, time.UTC), m.IssuedAt)
+	a.Nil(m.ExpirationTime)
+	a.Len(m.Resources, 2)
+	a.Equal(exampleMessage, m.String())
+}
+
+func TestMessageString(t *testing.T) {
+	a := assert.New(t)
+
+	expiration := time.Date(2021, 10, 1, 0, 0, 0, 500000000, time.UTC)
+	m := NewMessage("localhost:4361", common.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2"), "https://localhost/login", 5, "abcdefgh12")
+	m.Scheme = "https"
+	m.IssuedAt = time.Date(2021, 9, 30, 16, 25, 24, 0, time.UTC)
+	m.ExpirationTime = &expiration
+	m.NotBefore = &m.IssuedAt
+	m.RequestID = "request-1"
+
+	expected := `https://localhost:4361 wants you to sign in with your Ethereum account:
+0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2
+
+
+URI: https://localhost/login
+Version: 1
+Chain ID: 5
+Nonce: abcdefgh12
+Issued At: 2021-09-30T16:25:24Z
+Expiration Time: 2021-10-01T00:00:00.5Z
+Not Before: 2021-09-30T16:25:24Z
+Request ID: request-1`
+	a.Equal(expected, m.String())
+
+	parsed, err := ParseMessage(expected)
+	a.NoError(err)
+	a.Equal(m, parsed)
+}
+
+func TestParseInvalidMessage(t *testing.T) {
+	a := assert.New(t)
+
+	for _, text := range []string{
+		"",
+		"service.invalid wants you to sign in:\n0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2",
+		// not checksummed
+		"service.invalid wants you to sign in with your Ethereum account:\n0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2\n\n\nURI: https://service.invalid\nVersion: 1\nChain ID: 1\nNonce: 32891756\nIssued At: 2021-09-30T16:25:24Z",
+		// missing nonce
+		"service.invalid wants you to sign in with your Ethereum account:\n0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2\n\n\nURI: https://service.invalid\nVersion: 1\nChain ID: 1\nIssued At: 2021-09-30T16:25:24Z",
+		// short nonce
+		"service.invalid wants you to sign in with your Ethereum account:\n0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2\n\n\nURI: https://service.invalid\nVersion: 1\nChain ID: 1\nNonce: 1234\nIssued At: 2021-09-30T16:25:24Z",
+		// unsupported version
+		"service.invalid wants you to sign in with your Ethereum account:\n0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2\n\n\nURI: https://service.invalid\nVersion: 2\nChain ID: 1\nNonce: 32891756\nIssued At: 2021-09-30T16:25:24Z",
+		// trailing line
+		exampleMessage + "\n",
+	} {
+		_, err := ParseMessage(text)
+		a.ErrorIs(err, ErrInvalidMessage, text)
+	}
+}
+
+func TestGenerateNonce(t *testing.T) {
+	a := assert.New(t)
+
+	nonce, err := GenerateNonce()
+	a.NoError(err)
+	a.Len(nonce, 17)
+
+	other, _ := GenerateNonce()
+	a.NotEqual(nonce, other)
+
+	m := NewMessage("service.invalid", common.Address{}, "https://service.invalid", 1, nonce)
+	a.NoError(m.Validate())
+}
@@ -0,0 +1,92 @@
+package siwe
+
+import (
+	"time"
+
+	"github.com/openweb3/web3go/client"
+	"github.com/openweb3/web3go/sigverify"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrDomainMismatch   = errors.New("domain mismatch")
+	ErrNonceMismatch    = errors.New("nonce mismatch")
+	ErrChainIDMismatch  = errors.New("chain ID mismatch")
+	ErrExpired          = errors.New("message expired")
+	ErrNotYetValid      = errors.New("message not yet valid")
+	ErrInvalidSignature = errors.New("invalid signature")
+	ErrDomainRequired   = errors.New("expected domain is required")
+	ErrNonceRequired    = errors.New("expected nonce is required")
+)
+
+// VerifyOption specifies the expected fields of message. Domain and Nonce are required to prevent phishing and
+// replay attacks, and ChainID is not checked if zero.
+type VerifyOption struct {
+	// Domain is the expected domain, which should be the domain of server instead of the one sent by client.
+	Domain string
+	// Nonce is the expected nonce issued by server for the session.
+	Nonce string
+	// ChainID is the expected chain ID, it is not checked if zero.
+	ChainID uint64
+	// Time is the time to check ExpirationTime and NotBefore of message, it is the current time if zero.
+	Time time.Time
+}
+
+// Verifier verifies signed SIWE messages by sigverify.Verifier. Signatures of externally owned accounts are
+// recovered locally, and signatures of contract wallets are verified by EIP-1271 isValidSignature through eth_call,
+// including EIP-6492 signatures of contract wallets not deployed yet.
+type Verifier struct {
+	verifier *sigverify.Verifier
+}
+
+// NewVerifier creates a verifier, eth is used to verify signatures of contract wallets and could be nil to
+// verify signatures of externally owned accounts only.
+func NewVerifier(eth *client.RpcEthClient) *Verifier {
+	return &Verifier{sigverify.NewVerifier(eth)}
+}
+
+// Verify parses the message text, checks fields by option and verifies the signature of text by the message
+// address, the parsed message is returned if valid.
+func (v *Verifier) Verify(text string, signature []byte, option VerifyOption) (*Message, error) {
+	if option.Domain == "" {
+		return nil, ErrDomainRequired
+	}
+	if option.Nonce == "" {
+		return nil, ErrNonceRequired
+	}
+
+	m, err := ParseMessage(text)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.Domain != option.Domain {
+		return nil, errors.Wrapf(ErrDomainMismatch, "expect %v but got %v", option.Domain, m.Domain)
+	}
+	if m.Nonce != option.Nonce {
+		return nil, errors.Wrapf(ErrNonceMismatch, "expect %v but got %v", option.Nonce, m.Nonce)
+	}
+	if option.ChainID != 0 && m.ChainID != option.ChainID {
+		return nil, errors.Wrapf(ErrChainIDMismatch, "expect %v but got %v", option.ChainID, m.ChainID)
+	}
+
+	now := option.Time
+	if now.IsZero() {
+		now = time.Now()
+	}
+	if m.ExpirationTime != nil && !now.Before(*m.ExpirationTime) {
+		return nil, errors.Wrapf(ErrExpired, "expired at %v", formatTime(*m.ExpirationTime))
+	}
+	if m.NotBefore != nil && now.Before(*m.NotBefore) {
+		return nil, errors.Wrapf(ErrNotYetValid, "valid from %v", formatTime(*m.NotBefore))
+	}
+
+	result, err := v.verifier.VerifyMessage(m.Address, []byte(text), signature)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to verify signature")
+	}
+	if !result.Valid {
+		return nil, errors.Wrapf(ErrInvalidSignature, "%v: %v", result.Method, result.Reason)
+	}
+	return m, nil
+}
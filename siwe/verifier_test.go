@@ -0,0 +1,136 @@
+package siwe
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	rpc "github.com/openweb3/go-rpc-provider"
+	"github.com/openweb3/web3go/client"
+	"github.com/openweb3/web3go/signers"
+	"github.com/openweb3/web3go/sigverify"
+	"github.com/openweb3/web3go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	wallet       = common.HexToAddress("0x000000000000000000000000000000000000a11c")
+	walletAbi, _ = abi.JSON(strings.NewReader(sigverify.IsValidSignatureABI))
+)
+
+// walletChain serves the contract wallet which accepts signatures of owner by EIP-1271.
+type walletChain struct {
+	owner common.Address
+}
+
+func (c *walletChain) GetCode(addr common.Address, block *types.BlockNumberOrHash) hexutil.Bytes {
+	if addr == wallet {
+		return []byte{0x00}
+	}
+	return nil
+}
+
+func (c *walletChain) Call(req types.CallRequest, block *types.BlockNumberOrHash, overrides *types.StateOverride, blockOverrides *types.BlockOverrides) (hexutil.Bytes, error) {
+	if *req.To != wallet || !bytes.Equal(req.Data[:4], walletAbi.Methods["isValidSignature"].ID) {
+		return nil, nil
+	}
+	args, err := walletAbi.Methods["isValidSignature"].Inputs.Unpack(req.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	if sigverify.RecoverAddress(args[0].([32]byte), args[1].([]byte)) != c.owner {
+		return common.LeftPadBytes([]byte{0xff}, 32), nil
+	}
+	return common.RightPadBytes(sigverify.EIP1271MagicValue[:], 32), nil
+}
+
+func newTestMessage(address common.Address) *Message {
+	m := NewMessage("service.invalid", address, "https://service.invalid/login", 1, "32891756abc")
+	m.Statement = "Sign in"
+	expiration := m.IssuedAt.Add(time.Hour)
+	m.ExpirationTime = &expiration
+	return m
+}
+
+func TestVerify(t *testing.T) {
+	a := assert.New(t)
+	signer := signers.MustNewRandomPrivateKeySigner()
+	m := newTestMessage(signer.Address())
+	v := NewVerifier(nil)
+
+	sig, err := m.Sign(signer)
+	a.NoError(err)
+	_, err = m.Sign(signers.MustNewRandomPrivateKeySigner())
+	a.Error(err)
+
+	verified, err := v.Verify(m.String(), sig, VerifyOption{Domain: "service.invalid", Nonce: "32891756abc", ChainID: 1})
+	a.NoError(err)
+	a.Equal(m.Address, verified.Address)
+
+	// domain and nonce are required
+	_, err = v.Verify(m.String(), sig, VerifyOption{})
+	a.ErrorIs(err, ErrDomainRequired)
+	_, err = v.Verify(m.String(), sig, VerifyOption{Domain: "service.invalid"})
+	a.ErrorIs(err, ErrNonceRequired)
+
+	_, err = v.Verify(m.String(), sig, VerifyOption{Domain: "evil.invalid", Nonce: "32891756abc"})
+	a.ErrorIs(err, ErrDomainMismatch)
+	_, err = v.Verify(m.String(), sig, VerifyOption{Domain: "service.invalid", Nonce: "12345678"})
+	a.ErrorIs(err, ErrNonceMismatch)
+	_, err = v.Verify(m.String(), sig, VerifyOption{Domain: "service.invalid", Nonce: "32891756abc", ChainID: 5})
+	a.ErrorIs(err, ErrChainIDMismatch)
+	_, err = v.Verify(m.String(), sig, VerifyOption{Domain: "service.invalid", Nonce: "32891756abc", Time: m.ExpirationTime.Add(time.Second)})
+	a.ErrorIs(err, ErrExpired)
+
+	notBefore := m.IssuedAt.Add(time.Minute)
+	m.NotBefore = &notBefore
+	sig, _ = m.Sign(signer)
+	_, err = v.Verify(m.String(), sig, VerifyOption{Domain: "service.invalid", Nonce: "32891756abc", Time: m.IssuedAt})
+	a.ErrorIs(err, ErrNotYetValid)
+	_, err = v.Verify(m.String(), sig, VerifyOption{Domain: "service.invalid", Nonce: "32891756abc", Time: notBefore})
+	a.NoError(err)
+
+	// signed by others
+	other := newTestMessage(signer.Address())
+	other.Address = signers.MustNewRandomPrivateKeySigner().Address()
+	_, err = v.Verify(other.String(), sig, VerifyOption{Domain: "service.invalid", Nonce: "32891756abc"})
+	a.ErrorIs(err, ErrInvalidSignature)
+	_, err = v.Verify(m.String(), sig[:64], VerifyOption{Domain: "service.invalid", Nonce: "32891756abc", Time: notBefore})
+	a.ErrorIs(err, ErrInvalidSignature)
+}
+
+func TestVerifyContractWallet(t *testing.T) {
+	a := assert.New(t)
+	owner := signers.MustNewRandomPrivateKeySigner()
+
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", &walletChain{owner: owner.Address()}); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(server.Stop)
+	v := NewVerifier(client.NewRpcEthClient(rpc.DialInProc(server)))
+
+	m := newTestMessage(wallet)
+	sig, err := owner.SignMessage([]byte(m.String()))
+	a.NoError(err)
+	_, err = v.Verify(m.String(), sig, VerifyOption{Domain: "service.invalid", Nonce: "32891756abc"})
+	a.NoError(err)
+
+	// eth client is required to verify contract wallets
+	_, err = NewVerifier(nil).Verify(m.String(), sig, VerifyOption{Domain: "service.invalid", Nonce: "32891756abc"})
+	a.ErrorIs(err, ErrInvalidSignature)
+
+	sig, _ = signers.MustNewRandomPrivateKeySigner().SignMessage([]byte(m.String()))
+	_, err = v.Verify(m.String(), sig, VerifyOption{Domain: "service.invalid", Nonce: "32891756abc"})
+	a.ErrorIs(err, ErrInvalidSignature)
+
+	// address without code
+	m = newTestMessage(common.HexToAddress("0x000000000000000000000000000000000000b0b0"))
+	sig, _ = owner.SignMessage([]byte(m.String()))
+	_, err = v.Verify(m.String(), sig, VerifyOption{Domain: "service.invalid", Nonce: "32891756abc"})
+	a.ErrorIs(err, ErrInvalidSignature)
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// AccessListResult is the result of eth_createAccessList, which contains the access list of addresses and storage
+// keys touched by the call and the gas used by the call with the access list.
+//
+//go:generate gencodec -type AccessListResult -field-override accessListResultMarshaling -out gen_access_list_result_json.go
+type AccessListResult struct {
+	AccessList ethtypes.AccessList `json:"accessList"`
+	GasUsed    uint64              `json:"gasUsed"`
+	// Error is the error message if the call failed, such as reverted, the access list is still returned.
+	Error string `json:"error,omitempty"`
+}
+
+type accessListResultMarshaling struct {
+	GasUsed hexutil.Uint64 `json:"gasUsed"`
+}
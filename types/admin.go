@@ -0,0 +1,116 @@
+package types
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// AdminNodeInfo is the result of admin_nodeInfo.
+type AdminNodeInfo struct {
+	ID         string             `json:"id"`
+	Name       string             `json:"name"`
+	Enode      string             `json:"enode"`
+	ENR        string             `json:"enr"`
+	IP         string             `json:"ip"`
+	Ports      AdminNodePorts     `json:"ports"`
+	ListenAddr string             `json:"listenAddr"`
+	Protocols  AdminNodeProtocols `json:"protocols"`
+}
+
+type AdminNodePorts struct {
+	// Discovery is the UDP port of discovery protocol.
+	Discovery int `json:"discovery"`
+	// Listener is the TCP port of RLPx.
+	Listener int `json:"listener"`
+}
+
+type AdminNodeProtocols struct {
+	Eth *EthNodeInfo `json:"eth,omitempty"`
+}
+
+// EthNodeInfo is the eth protocol metadata of node.
+type EthNodeInfo struct {
+	Network uint64              `json:"network"`
+	Genesis common.Hash         `json:"genesis"`
+	Config  *params.ChainConfig `json:"config"`
+	Head    common.Hash         `json:"head"`
+}
+
+// AdminPeerInfo is a peer of admin_peers.
+type AdminPeerInfo struct {
+	ENR     string               `json:"enr,omitempty"`
+	Enode   string               `json:"enode"`
+	ID      string               `json:"id"`
+	Name    string               `json:"name"`
+	Caps    []string             `json:"caps"`
+	Network AdminPeerNetworkInfo `json:"network"`
+	// Protocols is keyed by the protocol name, such as eth and snap.
+	Protocols map[string]*AdminPeerProtocolInfo `json:"protocols"`
+}
+
+type AdminPeerNetworkInfo struct {
+	LocalAddress  string `json:"localAddress"`
+	RemoteAddress string `json:"remoteAddress"`
+	Inbound       bool   `json:"inbound"`
+	Trusted       bool   `json:"trusted"`
+	Static        bool   `json:"static"`
+}
+
+// AdminPeerProtocolInfo is the protocol metadata of peer, which is the negotiated version if the handshake is
+// done. Otherwise State is "handshake" or "unknown" and Version is 0.
+type AdminPeerProtocolInfo struct {
+	Version uint   `json:"version"`
+	State   string `json:"-"`
+}
+
+// MarshalJSON marshals as the state string if State is not empty.
+func (p AdminPeerProtocolInfo) MarshalJSON() ([]byte, error) {
+	if p.State != "" {
+		return json.Marshal(p.State)
+	}
+
+	type alias AdminPeerProtocolInfo
+	return json.Marshal(alias(p))
+}
+
+// UnmarshalJSON unmarshals from the protocol metadata or the state string.
+func (p *AdminPeerProtocolInfo) UnmarshalJSON(input []byte) error {
+	var state string
+	if err := json.Unmarshal(input, &state); err == nil {
+		*p = AdminPeerProtocolInfo{State: state}
+		return nil
+	}
+
+	type alias AdminPeerProtocolInfo
+	var dec alias
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	*p = AdminPeerProtocolInfo(dec)
+	return nil
+}
+
+type PeerEventType string
+
+const (
+	PeerEventTypeAdd     PeerEventType = "add"
+	PeerEventTypeDrop    PeerEventType = "drop"
+	PeerEventTypeMsgSend PeerEventType = "msgsend"
+	PeerEventTypeMsgRecv PeerEventType = "msgrecv"
+)
+
+// PeerEvent is the notification of admin_peerEvents subscription, which is sent when a peer is added or dropped,
+// or a message is sent to or received from a peer.
+type PeerEvent struct {
+	Type PeerEventType `json:"type"`
+	// Peer is the node ID of peer.
+	Peer          string  `json:"peer"`
+	Error         string  `json:"error,omitempty"`
+	Protocol      string  `json:"protocol,omitempty"`
+	MsgCode       *uint64 `json:"msg_code,omitempty"`
+	MsgSize       *uint32 `json:"msg_size,omitempty"`
+	LocalAddress  string  `json:"local,omitempty"`
+	RemoteAddress string  `json:"remote,omitempty"`
+}
@@ -0,0 +1,18 @@
+package types
+
+import "context"
+
+type withoutSigningKey struct{}
+
+// WithoutSigning returns a context, with which eth_sendTransaction requests are sent to node as is instead of being
+// signed locally by SignableMiddleware. It is used to send transactions from accounts managed by node, such as
+// accounts impersonated on dev nodes.
+func WithoutSigning(ctx context.Context) context.Context {
+	return context.WithValue(ctx, withoutSigningKey{}, true)
+}
+
+// IsWithoutSigning returns true if the context is created by WithoutSigning.
+func IsWithoutSigning(ctx context.Context) bool {
+	without, _ := ctx.Value(withoutSigningKey{}).(bool)
+	return without
+}
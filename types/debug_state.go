@@ -0,0 +1,168 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+)
+
+// StorageRangeResult is the result of debug_storageRangeAt.
+type StorageRangeResult struct {
+	// Storage is keyed by the hash of storage key.
+	Storage map[common.Hash]*StorageEntry `json:"storage"`
+	// NextKey is the hash of storage key to request the next range, it is nil if Storage includes the last key.
+	NextKey *common.Hash `json:"nextKey"`
+}
+
+// StorageEntry is a storage slot of StorageRangeResult.
+type StorageEntry struct {
+	// Key is nil if the preimage of the key hash is not available on node.
+	Key   *common.Hash `json:"key"`
+	Value common.Hash  `json:"value"`
+}
+
+// StateDump is the result of debug_dumpBlock and debug_accountRange.
+type StateDump struct {
+	Root common.Hash `json:"root"`
+	// Accounts is keyed by the checksum address, or by "pre(<address hash>)" if the preimage of address hash is
+	// not available on node.
+	Accounts map[string]*DumpAccount `json:"accounts"`
+	// Next is the address hash to request the next range of debug_accountRange, it is nil if there is no more
+	// accounts.
+	Next []byte `json:"next,omitempty"`
+}
+
+// DumpAccount is an account of StateDump.
+type DumpAccount struct {
+	Balance *big.Int `json:"balance"`
+	Nonce   uint64   `json:"nonce"`
+	// Root is the storage root.
+	Root     common.Hash `json:"root"`
+	CodeHash common.Hash `json:"codeHash"`
+	// Code is nil if code is skipped.
+	Code []byte `json:"code,omitempty"`
+	// Storage is nil if storage is skipped.
+	Storage map[common.Hash]common.Hash `json:"storage,omitempty"`
+	// Address is nil if the preimage of address hash is not available on node.
+	Address     *common.Address `json:"address,omitempty"`
+	AddressHash *common.Hash    `json:"key,omitempty"`
+}
+
+// stateDumpJSON is the JSON format of geth, where the state root is hex without 0x prefix and next key is base64.
+type stateDumpJSON struct {
+	Root     string                  `json:"root"`
+	Accounts map[string]*DumpAccount `json:"accounts"`
+	Next     []byte                  `json:"next,omitempty"`
+}
+
+// MarshalJSON marshals as JSON.
+func (d StateDump) MarshalJSON() ([]byte, error) {
+	return json.Marshal(stateDumpJSON{
+		Root:     common.Bytes2Hex(d.Root[:]),
+		Accounts: d.Accounts,
+		Next:     d.Next,
+	})
+}
+
+// UnmarshalJSON unmarshals from JSON.
+func (d *StateDump) UnmarshalJSON(input []byte) error {
+	var dec stateDumpJSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+
+	root, err := decodeUnprefixedHex(dec.Root)
+	if err != nil {
+		return errors.WithMessage(err, "invalid root")
+	}
+	d.Root = common.BytesToHash(root)
+	d.Accounts = dec.Accounts
+	d.Next = dec.Next
+	return nil
+}
+
+// dumpAccountJSON is the JSON format of geth, where the balance is decimal and storage values are hex without 0x
+// prefix.
+type dumpAccountJSON struct {
+	Balance     string                 `json:"balance"`
+	Nonce       uint64                 `json:"nonce"`
+	Root        common.Hash            `json:"root"`
+	CodeHash    common.Hash            `json:"codeHash"`
+	Code        hexutil.Bytes          `json:"code,omitempty"`
+	Storage     map[common.Hash]string `json:"storage,omitempty"`
+	Address     *common.Address        `json:"address,omitempty"`
+	AddressHash *common.Hash           `json:"key,omitempty"`
+}
+
+// MarshalJSON marshals as JSON.
+func (a DumpAccount) MarshalJSON() ([]byte, error) {
+	enc := dumpAccountJSON{
+		Balance:     "0",
+		Nonce:       a.Nonce,
+		Root:        a.Root,
+		CodeHash:    a.CodeHash,
+		Code:        a.Code,
+		Address:     a.Address,
+		AddressHash: a.AddressHash,
+	}
+	if a.Balance != nil {
+		enc.Balance = a.Balance.String()
+	}
+	if a.Storage != nil {
+		enc.Storage = make(map[common.Hash]string, len(a.Storage))
+		for k, v := range a.Storage {
+			enc.Storage[k] = common.Bytes2Hex(common.TrimLeftZeroes(v[:]))
+		}
+	}
+	return json.Marshal(&enc)
+}
+
+// UnmarshalJSON unmarshals from JSON.
+func (a *DumpAccount) UnmarshalJSON(input []byte) error {
+	var dec dumpAccountJSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+
+	balance, ok := new(big.Int).SetString(dec.Balance, 10)
+	if !ok {
+		return errors.Errorf("invalid balance %v", dec.Balance)
+	}
+
+	var storage map[common.Hash]common.Hash
+	if dec.Storage != nil {
+		storage = make(map[common.Hash]common.Hash, len(dec.Storage))
+		for k, v := range dec.Storage {
+			value, err := decodeUnprefixedHex(v)
+			if err != nil {
+				return errors.WithMessagef(err, "invalid storage %v", k)
+			}
+			storage[k] = common.BytesToHash(value)
+		}
+	}
+
+	*a = DumpAccount{
+		Balance:     balance,
+		Nonce:       dec.Nonce,
+		Root:        dec.Root,
+		CodeHash:    dec.CodeHash,
+		Code:        dec.Code,
+		Storage:     storage,
+		Address:     dec.Address,
+		AddressHash: dec.AddressHash,
+	}
+	return nil
+}
+
+// decodeUnprefixedHex decodes hex with or without 0x prefix, odd length is allowed.
+func decodeUnprefixedHex(s string) ([]byte, error) {
+	s = strings.TrimPrefix(s, "0x")
+	if len(s)%2 == 1 {
+		s = "0" + s
+	}
+	return hexutil.Decode("0x" + s)
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStateDumpJSON(t *testing.T) {
+	a := assert.New(t)
+
+	// format of geth, root is hex without 0x prefix and next is base64
+	input := `{"root":"0102000000000000000000000000000000000000000000000000000000000000","accounts":{"0x0000000000000000000000000000000000000001":{"balance":"1000","nonce":2,"root":"0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421","codeHash":"0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470","storage":{"0x0000000000000000000000000000000000000000000000000000000000000001":"ff"},"address":"0x0000000000000000000000000000000000000001","key":"0x1468288056310c82aa4c01a7e12a10f8111a0560e72b700555479031b86c357d"}},"next":"AQI="}`
+
+	var dump StateDump
+	a.NoError(json.Unmarshal([]byte(input), &dump))
+	a.Equal(common.HexToHash("0x0102000000000000000000000000000000000000000000000000000000000000"), dump.Root)
+	a.Equal([]byte{1, 2}, dump.Next)
+
+	account := dump.Accounts["0x0000000000000000000000000000000000000001"]
+	a.Equal(big.NewInt(1000), account.Balance)
+	a.Equal(uint64(2), account.Nonce)
+	a.Equal(common.HexToHash("0xff"), account.Storage[common.HexToHash("0x01")])
+	a.Equal(common.HexToAddress("0x01"), *account.Address)
+
+	output, err := json.Marshal(dump)
+	a.NoError(err)
+	a.JSONEq(input, string(output))
+}
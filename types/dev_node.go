@@ -0,0 +1,24 @@
+package types
+
+import "github.com/ethereum/go-ethereum/common/hexutil"
+
+// DevNamespace is the namespace of methods that control dev nodes, anvil supports both of them.
+type DevNamespace string
+
+const (
+	DevNamespaceAnvil   DevNamespace = "anvil"
+	DevNamespaceHardhat DevNamespace = "hardhat"
+)
+
+// DevResetOptions is the options to reset the chain of dev nodes, the chain is reset to a fresh local chain if
+// Forking is nil.
+type DevResetOptions struct {
+	Forking *DevForkingOptions `json:"forking,omitempty"`
+}
+
+// DevForkingOptions specifies the remote chain to fork from, it is forked from the latest block if BlockNumber
+// is nil.
+type DevForkingOptions struct {
+	JsonRpcUrl  string          `json:"jsonRpcUrl"`
+	BlockNumber *hexutil.Uint64 `json:"blockNumber,omitempty"`
+}
@@ -45,6 +45,7 @@ func (b Block) MarshalJSON() ([]byte, error) {
 		BlobGasUsed           hexutil.Uint64     `json:"blobGasUsed,omitempty"`
 		ExcessBlobGas         hexutil.Uint64     `json:"excessBlobGas,omitempty"`
 		ParentBeaconBlockRoot *common.Hash       `json:"parentBeaconBlockRoot,omitempty"`
+		RequestsHash          *common.Hash       `json:"requestsHash,omitempty"`
 	}
 	var enc Block
 	enc.Author = b.Author
@@ -75,6 +76,7 @@ func (b Block) MarshalJSON() ([]byte, error) {
 	enc.BlobGasUsed = hexutil.Uint64(b.BlobGasUsed)
 	enc.ExcessBlobGas = hexutil.Uint64(b.ExcessBlobGas)
 	enc.ParentBeaconBlockRoot = b.ParentBeaconBlockRoot
+	enc.RequestsHash = b.RequestsHash
 	return json.Marshal(&enc)
 }
 
@@ -109,6 +111,7 @@ func (b *Block) UnmarshalJSON(input []byte) error {
 		BlobGasUsed           *hexutil.Uint64    `json:"blobGasUsed,omitempty"`
 		ExcessBlobGas         *hexutil.Uint64    `json:"excessBlobGas,omitempty"`
 		ParentBeaconBlockRoot *common.Hash       `json:"parentBeaconBlockRoot,omitempty"`
+		RequestsHash          *common.Hash       `json:"requestsHash,omitempty"`
 	}
 	var dec Block
 	if err := json.Unmarshal(input, &dec); err != nil {
@@ -200,5 +203,8 @@ func (b *Block) UnmarshalJSON(input []byte) error {
 	if dec.ParentBeaconBlockRoot != nil {
 		b.ParentBeaconBlockRoot = dec.ParentBeaconBlockRoot
 	}
+	if dec.RequestsHash != nil {
+		b.RequestsHash = dec.RequestsHash
+	}
 	return nil
 }
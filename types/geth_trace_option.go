@@ -1,6 +1,10 @@
 package types
 
-import "encoding/json"
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+)
 
 // GethDebugTracingOptions represents the tracing options for Geth debug tracing
 type GethDebugTracingOptions struct {
@@ -8,6 +12,11 @@ type GethDebugTracingOptions struct {
 	Tracer       string                 `json:"tracer,omitempty"`
 	TracerConfig *GethDebugTracerConfig `json:"tracerConfig,omitempty"`
 	Timeout      *string                `json:"timeout,omitempty"`
+
+	// StateOverrides and BlockOverrides are applied before tracing, they are only supported by debug_traceCall
+	// and debug_traceCallMany.
+	StateOverrides *StateOverride  `json:"stateOverrides,omitempty"`
+	BlockOverrides *BlockOverrides `json:"blockOverrides,omitempty"`
 }
 
 // GethDefaultTracingOptions represents the default tracing options for the struct logger
@@ -22,6 +31,30 @@ type GethDefaultTracingOptions struct {
 	Limit             *uint64 `json:"limit,omitempty"`
 }
 
+// GethStdTraceOptions represents the options of debug_standardTraceBlockToFile, which writes struct logs of
+// transactions to files on node.
+type GethStdTraceOptions struct {
+	GethDefaultTracingOptions
+	Reexec *uint64 `json:"reexec,omitempty"`
+	// TxHash traces only the transaction if specified.
+	TxHash *common.Hash `json:"txHash,omitempty"`
+}
+
+// CallBundle is a bundle of calls of debug_traceCallMany, the calls are executed in sequence on the state of
+// previous calls and bundles.
+type CallBundle struct {
+	Transactions  []CallRequest   `json:"transactions"`
+	BlockOverride *BlockOverrides `json:"blockOverride,omitempty"`
+}
+
+// StateContext specifies the state that bundles of debug_traceCallMany are executed on.
+type StateContext struct {
+	BlockNumber BlockNumberOrHash `json:"blockNumber"`
+	// TransactionIndex executes bundles after transactions before the index in block are executed, all
+	// transactions in block are executed if nil.
+	TransactionIndex *int `json:"transactionIndex,omitempty"`
+}
+
 // GethDebugTracerConfig is a wrapper around json.RawMessage for tracer configuration
 type GethDebugTracerConfig struct {
 	CallConfig     *CallConfig
@@ -0,0 +1,183 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// OtsOperationType is the type of internal operations of ots_getInternalOperations.
+type OtsOperationType int
+
+const (
+	OtsOperationTransfer     OtsOperationType = 0
+	OtsOperationSelfDestruct OtsOperationType = 1
+	OtsOperationCreate       OtsOperationType = 2
+	OtsOperationCreate2      OtsOperationType = 3
+)
+
+// OtsInternalOperation is an internal operation that transfers value or creates contract, it is not recorded
+// in the receipt of transaction.
+//
+//go:generate gencodec -type OtsInternalOperation -field-override otsInternalOperationMarshaling -out gen_ots_internal_operation_json.go
+type OtsInternalOperation struct {
+	Type  OtsOperationType `json:"type"`
+	From  common.Address   `json:"from"`
+	To    common.Address   `json:"to"`
+	Value *big.Int         `json:"value"`
+}
+
+type otsInternalOperationMarshaling struct {
+	Value *hexutil.Big `json:"value"`
+}
+
+// OtsTraceEntry is a call of ots_traceTransaction, calls are in the order of execution and nested calls are
+// indicated by Depth.
+//
+//go:generate gencodec -type OtsTraceEntry -field-override otsTraceEntryMarshaling -out gen_ots_trace_entry_json.go
+type OtsTraceEntry struct {
+	// Type is the opcode of call, such as CALL, STATICCALL, DELEGATECALL, CREATE2 and SELFDESTRUCT.
+	Type  string         `json:"type"`
+	Depth int            `json:"depth"`
+	From  common.Address `json:"from"`
+	To    common.Address `json:"to"`
+	// Value is nil for STATICCALL and DELEGATECALL.
+	Value  *big.Int `json:"value"`
+	Input  []byte   `json:"input"`
+	Output []byte   `json:"output,omitempty"`
+}
+
+type otsTraceEntryMarshaling struct {
+	Value  *hexutil.Big  `json:"value"`
+	Input  hexutil.Bytes `json:"input"`
+	Output hexutil.Bytes `json:"output,omitempty"`
+}
+
+// OtsContractCreator is the result of ots_getContractCreator.
+type OtsContractCreator struct {
+	Hash    common.Hash    `json:"hash"`
+	Creator common.Address `json:"creator"`
+}
+
+// OtsBlockDetails is the result of ots_getBlockDetails.
+//
+//go:generate gencodec -type OtsBlockDetails -field-override otsBlockDetailsMarshaling -out gen_ots_block_details_json.go
+type OtsBlockDetails struct {
+	Block     *OtsBlock        `json:"block"`
+	Issuance  OtsBlockIssuance `json:"issuance"`
+	TotalFees *big.Int         `json:"totalFees"`
+}
+
+type otsBlockDetailsMarshaling struct {
+	TotalFees *hexutil.Big `json:"totalFees"`
+}
+
+//go:generate gencodec -type OtsBlockIssuance -field-override otsBlockIssuanceMarshaling -out gen_ots_block_issuance_json.go
+type OtsBlockIssuance struct {
+	BlockReward *big.Int `json:"blockReward"`
+	UncleReward *big.Int `json:"uncleReward"`
+	Issuance    *big.Int `json:"issuance"`
+}
+
+type otsBlockIssuanceMarshaling struct {
+	BlockReward *hexutil.Big `json:"blockReward"`
+	UncleReward *hexutil.Big `json:"uncleReward"`
+	Issuance    *hexutil.Big `json:"issuance"`
+}
+
+// OtsBlock is the block of ots_getBlockDetails, in which Transactions is empty and LogsBloom is zero.
+type OtsBlock struct {
+	Block
+	TransactionCount uint64 `json:"transactionCount"`
+}
+
+type otsBlockExtra struct {
+	TransactionCount uint64 `json:"transactionCount"`
+}
+
+func (b OtsBlock) MarshalJSON() ([]byte, error) {
+	return marshalWithExtra(b.Block, otsBlockExtra{b.TransactionCount})
+}
+
+func (b *OtsBlock) UnmarshalJSON(input []byte) error {
+	var extra otsBlockExtra
+	if err := unmarshalWithExtra(input, &b.Block, &extra); err != nil {
+		return err
+	}
+	b.TransactionCount = extra.TransactionCount
+	return nil
+}
+
+// OtsSearchResult is a page of transactions of ots_searchTransactionsBefore and ots_searchTransactionsAfter,
+// transactions are in descending order and the receipt of each transaction is at the same index of Receipts.
+type OtsSearchResult struct {
+	Txs      []*TransactionDetail `json:"txs"`
+	Receipts []*OtsReceipt        `json:"receipts"`
+	// FirstPage is true if the page contains the latest transactions.
+	FirstPage bool `json:"firstPage"`
+	// LastPage is true if the page contains the earliest transactions.
+	LastPage bool `json:"lastPage"`
+}
+
+// OtsReceipt is the receipt with the timestamp of block.
+type OtsReceipt struct {
+	Receipt
+	Timestamp uint64 `json:"timestamp"`
+}
+
+type otsReceiptExtra struct {
+	Timestamp otsQuantity `json:"timestamp"`
+}
+
+func (r OtsReceipt) MarshalJSON() ([]byte, error) {
+	return marshalWithExtra(r.Receipt, otsReceiptExtra{otsQuantity(r.Timestamp)})
+}
+
+func (r *OtsReceipt) UnmarshalJSON(input []byte) error {
+	var extra otsReceiptExtra
+	if err := unmarshalWithExtra(input, &r.Receipt, &extra); err != nil {
+		return err
+	}
+	r.Timestamp = uint64(extra.Timestamp)
+	return nil
+}
+
+// otsQuantity is marshaled as a quantity, and unmarshaled from both a quantity and a number, since erigon encodes
+// the receipt timestamp as a number.
+type otsQuantity uint64
+
+func (q otsQuantity) MarshalJSON() ([]byte, error) {
+	return json.Marshal(hexutil.Uint64(q))
+}
+
+func (q *otsQuantity) UnmarshalJSON(input []byte) error {
+	if len(input) > 0 && input[0] == '"' {
+		return (*hexutil.Uint64)(q).UnmarshalJSON(input)
+	}
+	return json.Unmarshal(input, (*uint64)(q))
+}
+
+// marshalWithExtra marshals the embedded value, whose MarshalJSON is promoted and hides fields of the outer
+// struct, and merges fields of extra into it.
+func marshalWithExtra(embedded, extra interface{}) ([]byte, error) {
+	var fields map[string]json.RawMessage
+	for _, v := range []interface{}{embedded, extra} {
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(encoded, &fields); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(fields)
+}
+
+func unmarshalWithExtra(input []byte, embedded, extra interface{}) error {
+	if err := json.Unmarshal(input, embedded); err != nil {
+		return err
+	}
+	return json.Unmarshal(input, extra)
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOtsBlockDetailsJSON(t *testing.T) {
+	a := assert.New(t)
+
+	// format of erigon, transactionCount is a number and logsBloom is null
+	input := `{"block":{"difficulty":"0x0","extraData":"0x","gasLimit":"0x1c9c380","gasUsed":"0x5208","hash":"0x0000000000000000000000000000000000000000000000000000000000000001","logsBloom":null,"miner":"0x0000000000000000000000000000000000001234","number":"0x10","parentHash":"0x0000000000000000000000000000000000000000000000000000000000000000","receiptsRoot":"0x0000000000000000000000000000000000000000000000000000000000000000","sha3Uncles":"0x0000000000000000000000000000000000000000000000000000000000000000","size":"0x100","stateRoot":"0x0000000000000000000000000000000000000000000000000000000000000000","timestamp":"0x64","transactionCount":3,"transactionsRoot":"0x0000000000000000000000000000000000000000000000000000000000000000","uncles":[]},"issuance":{"blockReward":"0x1bc16d674ec80000","uncleReward":"0x0","issuance":"0x1bc16d674ec80000"},"totalFees":"0x3e8"}`
+
+	var details OtsBlockDetails
+	a.NoError(json.Unmarshal([]byte(input), &details))
+	a.Equal(big.NewInt(16), details.Block.Number)
+	a.Equal(uint64(3), details.Block.TransactionCount)
+	a.Equal(common.HexToAddress("0x1234"), details.Block.Miner)
+	a.Equal(big.NewInt(2e18), details.Issuance.BlockReward)
+	a.Equal(big.NewInt(1000), details.TotalFees)
+
+	output, err := json.Marshal(details)
+	a.NoError(err)
+
+	var decoded OtsBlockDetails
+	a.NoError(json.Unmarshal(output, &decoded))
+	a.Equal(details, decoded)
+}
+
+func TestOtsReceiptJSON(t *testing.T) {
+	a := assert.New(t)
+
+	input := `{"blockHash":"0x0000000000000000000000000000000000000000000000000000000000000001","blockNumber":"0x10","contractAddress":null,"cumulativeGasUsed":"0x5208","effectiveGasPrice":"0x1","from":"0x0000000000000000000000000000000000001234","gasUsed":"0x5208","logs":[],"logsBloom":"0x00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","status":"0x1","timestamp":"0x64","to":null,"transactionHash":"0x0000000000000000000000000000000000000000000000000000000000000002","transactionIndex":"0x0"}`
+
+	var receipt OtsReceipt
+	a.NoError(json.Unmarshal([]byte(input), &receipt))
+	a.Equal(uint64(16), receipt.BlockNumber)
+	a.Equal(uint64(100), receipt.Timestamp)
+
+	output, err := json.Marshal(receipt)
+	a.NoError(err)
+	a.JSONEq(input, string(output))
+
+	// format of erigon, timestamp is a number
+	var erigon OtsReceipt
+	a.NoError(json.Unmarshal([]byte(strings.Replace(input, `"timestamp":"0x64"`, `"timestamp":100`, 1)), &erigon))
+	a.Equal(receipt, erigon)
+}
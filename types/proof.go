@@ -0,0 +1,165 @@
+package types
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidProof = errors.New("invalid proof")
+)
+
+// AccountProof is the result of eth_getProof, which contains the Merkle-Patricia proofs of an account and its
+// storage slots.
+//
+//go:generate gencodec -type AccountProof -field-override accountProofMarshaling -out gen_account_proof_json.go
+type AccountProof struct {
+	Address      common.Address  `json:"address"`
+	AccountProof [][]byte        `json:"accountProof"`
+	Balance      *big.Int        `json:"balance"`
+	CodeHash     common.Hash     `json:"codeHash"`
+	Nonce        uint64          `json:"nonce"`
+	StorageHash  common.Hash     `json:"storageHash"`
+	StorageProof []*StorageProof `json:"storageProof"`
+}
+
+type accountProofMarshaling struct {
+	AccountProof []hexutil.Bytes `json:"accountProof"`
+	Balance      *hexutil.Big    `json:"balance"`
+	Nonce        hexutil.Uint64  `json:"nonce"`
+}
+
+// StorageProof is the Merkle-Patricia proof of a storage slot.
+//
+//go:generate gencodec -type StorageProof -field-override storageProofMarshaling -out gen_storage_proof_json.go
+type StorageProof struct {
+	// Key is the storage key as requested, it may be not 32 bytes, such as 0x0.
+	Key   string   `json:"key"`
+	Value *big.Int `json:"value"`
+	Proof [][]byte `json:"proof"`
+}
+
+type storageProofMarshaling struct {
+	Value *hexutil.Big    `json:"value"`
+	Proof []hexutil.Bytes `json:"proof"`
+}
+
+// Match checks the proof is of the requested account and storage keys in order, since Verify only verifies
+// the account and storage keys in the proof itself.
+func (p *AccountProof) Match(address common.Address, storageKeys []common.Hash) error {
+	if p.Address != address {
+		return errors.Wrapf(ErrInvalidProof, "account should be %v but got %v", address, p.Address)
+	}
+	if len(p.StorageProof) != len(storageKeys) {
+		return errors.Wrapf(ErrInvalidProof, "%v storage proofs expected but got %v", len(storageKeys), len(p.StorageProof))
+	}
+	for i, storage := range p.StorageProof {
+		if storage == nil || common.HexToHash(storage.Key) != storageKeys[i] {
+			return errors.Wrapf(ErrInvalidProof, "storage key #%v should be %v", i, storageKeys[i])
+		}
+	}
+	return nil
+}
+
+// Verify verifies the account proof against the state root of a trusted block header, and verifies all
+// storage proofs against the verified storage hash, so the balance, nonce, code hash and storage values are
+// able to be trusted without trusting the RPC node.
+//
+// The proof of an absent account proves the account is empty.
+func (p *AccountProof) Verify(stateRoot common.Hash) error {
+	value, err := trie.VerifyProof(stateRoot, crypto.Keccak256(p.Address.Bytes()), proofDB(p.AccountProof))
+	if err != nil {
+		return errors.Wrapf(ErrInvalidProof, "account %v: %v", p.Address, err)
+	}
+
+	account := ethtypes.NewEmptyStateAccount()
+	if value != nil {
+		if err := rlp.DecodeBytes(value, account); err != nil {
+			return errors.Wrapf(ErrInvalidProof, "failed to decode account %v: %v", p.Address, err)
+		}
+	}
+
+	if err := p.checkAccount(account, value == nil); err != nil {
+		return errors.Wrapf(ErrInvalidProof, "account %v: %v", p.Address, err)
+	}
+
+	for _, storage := range p.StorageProof {
+		if err := storage.Verify(account.Root); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *AccountProof) checkAccount(account *ethtypes.StateAccount, absent bool) error {
+	if p.Nonce != account.Nonce {
+		return errors.Errorf("nonce should be %v but got %v", account.Nonce, p.Nonce)
+	}
+
+	balance := p.Balance
+	if balance == nil {
+		balance = new(big.Int)
+	}
+	if balance.Cmp(account.Balance.ToBig()) != 0 {
+		return errors.Errorf("balance should be %v but got %v", account.Balance, balance)
+	}
+
+	// nodes may return zero hashes for absent accounts
+	if !(absent && p.CodeHash == (common.Hash{})) && !bytes.Equal(p.CodeHash[:], account.CodeHash) {
+		return errors.Errorf("code hash should be %x but got %v", account.CodeHash, p.CodeHash)
+	}
+	if !(absent && p.StorageHash == (common.Hash{})) && p.StorageHash != account.Root {
+		return errors.Errorf("storage hash should be %v but got %v", account.Root, p.StorageHash)
+	}
+	return nil
+}
+
+// Verify verifies the storage proof against the verified storage hash of account, the value of an absent slot
+// is 0.
+func (p *StorageProof) Verify(storageHash common.Hash) error {
+	var value []byte
+	// the storage trie of an empty account has no node to prove
+	if storageHash != ethtypes.EmptyRootHash || len(p.Proof) > 0 {
+		key := common.HexToHash(p.Key)
+		var err error
+		if value, err = trie.VerifyProof(storageHash, crypto.Keccak256(key.Bytes()), proofDB(p.Proof)); err != nil {
+			return errors.Wrapf(ErrInvalidProof, "storage %v: %v", p.Key, err)
+		}
+	}
+
+	expected := new(big.Int)
+	if value != nil {
+		var content []byte
+		if err := rlp.DecodeBytes(value, &content); err != nil {
+			return errors.Wrapf(ErrInvalidProof, "failed to decode storage %v: %v", p.Key, err)
+		}
+		expected.SetBytes(content)
+	}
+
+	actual := p.Value
+	if actual == nil {
+		actual = new(big.Int)
+	}
+	if actual.Cmp(expected) != 0 {
+		return errors.Wrapf(ErrInvalidProof, "storage %v should be %v but got %v", p.Key, expected, actual)
+	}
+	return nil
+}
+
+// proofDB returns the database of proof nodes keyed by their hashes.
+func proofDB(proof [][]byte) *memorydb.Database {
+	db := memorydb.New()
+	for _, node := range proof {
+		db.Put(crypto.Keccak256(node), node)
+	}
+	return db
+}
@@ -0,0 +1,152 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/assert"
+)
+
+// proofList collects proof nodes written by Trie.Prove.
+type proofList [][]byte
+
+func (l *proofList) Put(key []byte, value []byte) error {
+	*l = append(*l, value)
+	return nil
+}
+
+func (l *proofList) Delete(key []byte) error {
+	panic("not supported")
+}
+
+// newTestProof returns proofs of account and slots generated by go-ethereum, as returned by eth_getProof.
+func newTestProof(t *testing.T, db state.Database, root common.Hash, address common.Address, slots ...common.Hash) *AccountProof {
+	statedb, err := state.New(root, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	accountTrie, err := db.OpenTrie(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	proof := &AccountProof{
+		Address:     address,
+		Balance:     statedb.GetBalance(address).ToBig(),
+		CodeHash:    statedb.GetCodeHash(address),
+		Nonce:       statedb.GetNonce(address),
+		StorageHash: statedb.GetStorageRoot(address),
+	}
+	var accountProof proofList
+	if err := accountTrie.Prove(crypto.Keccak256(address.Bytes()), &accountProof); err != nil {
+		t.Fatal(err)
+	}
+	proof.AccountProof = accountProof
+
+	if proof.StorageHash == (common.Hash{}) {
+		proof.StorageHash = ethtypes.EmptyRootHash
+	}
+	storageTrie, err := db.OpenStorageTrie(root, address, proof.StorageHash, accountTrie)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, slot := range slots {
+		var storageProof proofList
+		if err := storageTrie.Prove(crypto.Keccak256(slot.Bytes()), &storageProof); err != nil {
+			t.Fatal(err)
+		}
+		proof.StorageProof = append(proof.StorageProof, &StorageProof{
+			Key:   slot.Hex(),
+			Value: statedb.GetState(address, slot).Big(),
+			Proof: storageProof,
+		})
+	}
+	return proof
+}
+
+func TestAccountProofVerify(t *testing.T) {
+	a := assert.New(t)
+
+	contract := common.HexToAddress("0x000000000000000000000000000000000000c0de")
+	eoa := common.HexToAddress("0x000000000000000000000000000000000000a11c")
+	absent := common.HexToAddress("0x000000000000000000000000000000000000dead")
+
+	db := state.NewDatabaseForTesting()
+	statedb, _ := state.New(ethtypes.EmptyRootHash, db)
+	statedb.SetCode(contract, []byte{0x00})
+	statedb.SetState(contract, common.HexToHash("0x01"), common.HexToHash("0x2a"))
+	statedb.SetState(contract, common.HexToHash("0x02"), common.HexToHash("0xff00"))
+	statedb.SetNonce(eoa, 3, 0)
+	statedb.SetBalance(eoa, uint256.NewInt(1e18), 0)
+	root, err := statedb.Commit(1, true, false)
+	a.NoError(err)
+
+	proof := newTestProof(t, db, root, contract, common.HexToHash("0x01"), common.HexToHash("0x02"), common.HexToHash("0x03"))
+	a.NoError(proof.Verify(root))
+	a.Equal(big.NewInt(0x2a), proof.StorageProof[0].Value)
+
+	// proofs of json round trip
+	j, err := json.Marshal(proof)
+	a.NoError(err)
+	var decoded AccountProof
+	a.NoError(json.Unmarshal(j, &decoded))
+	a.Equal(proof, &decoded)
+	decoded.StorageProof[0].Key = "0x1"
+	a.NoError(decoded.Verify(root))
+
+	a.NoError(newTestProof(t, db, root, eoa).Verify(root))
+	a.NoError(newTestProof(t, db, root, absent, common.HexToHash("0x01")).Verify(root))
+
+	// tampered values
+	proof = newTestProof(t, db, root, eoa)
+	proof.Balance = big.NewInt(2e18)
+	a.ErrorIs(proof.Verify(root), ErrInvalidProof)
+
+	proof = newTestProof(t, db, root, eoa)
+	proof.Nonce = 4
+	a.ErrorIs(proof.Verify(root), ErrInvalidProof)
+
+	proof = newTestProof(t, db, root, contract, common.HexToHash("0x01"))
+	proof.StorageProof[0].Value = big.NewInt(1)
+	a.ErrorIs(proof.Verify(root), ErrInvalidProof)
+
+	proof = newTestProof(t, db, root, contract, common.HexToHash("0x01"))
+	proof.CodeHash = ethtypes.EmptyCodeHash
+	a.ErrorIs(proof.Verify(root), ErrInvalidProof)
+
+	// absent slot is 0
+	proof = newTestProof(t, db, root, contract, common.HexToHash("0x03"))
+	proof.StorageProof[0].Value = big.NewInt(1)
+	a.ErrorIs(proof.Verify(root), ErrInvalidProof)
+
+	// untrusted state root
+	a.ErrorIs(newTestProof(t, db, root, eoa).Verify(common.HexToHash("0x01")), ErrInvalidProof)
+
+	// proof of other account
+	proof = newTestProof(t, db, root, contract)
+	proof.Address = eoa
+	a.ErrorIs(proof.Verify(root), ErrInvalidProof)
+}
+
+func TestAccountProofMatch(t *testing.T) {
+	a := assert.New(t)
+
+	account := common.HexToAddress("0x000000000000000000000000000000000000c0de")
+	slots := []common.Hash{common.HexToHash("0x01"), common.HexToHash("0x02")}
+	proof := &AccountProof{
+		Address:      account,
+		StorageProof: []*StorageProof{{Key: "0x1"}, {Key: slots[1].Hex()}},
+	}
+	a.NoError(proof.Match(account, slots))
+
+	a.ErrorIs(proof.Match(common.HexToAddress("0x000000000000000000000000000000000000a11c"), slots), ErrInvalidProof)
+	a.ErrorIs(proof.Match(account, slots[:1]), ErrInvalidProof)
+	a.ErrorIs(proof.Match(account, []common.Hash{slots[1], slots[0]}), ErrInvalidProof)
+	a.ErrorIs(proof.Match(account, []common.Hash{slots[0], common.HexToHash("0x03")}), ErrInvalidProof)
+}
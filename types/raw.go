@@ -0,0 +1,244 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrDataMismatch = errors.New("data mismatch")
+)
+
+// VerifyRawHeader checks block against the raw header decoded from RLP, such as the header of debug_getRawHeader.
+// The hash of raw header must be the block hash, and the header fields of block must produce the same hash,
+// otherwise the mismatched fields are reported.
+func (b *Block) VerifyRawHeader(raw *ethtypes.Header) error {
+	if hash := raw.Hash(); hash != b.Hash {
+		return errors.Wrapf(ErrDataMismatch, "hash of raw header is %v but block hash is %v", hash, b.Hash)
+	}
+
+	h, err := b.Header()
+	if err != nil {
+		return err
+	}
+	if h.Header.Hash() == b.Hash {
+		return nil
+	}
+	return mismatchedFields("header", &h.Header, raw)
+}
+
+// VerifyRaw checks block against the raw block decoded from RLP, such as the block of debug_getRawBlock.
+//
+// The transactions, uncles and withdrawals of raw block are verified against the roots of its header first, then
+// the header is checked by VerifyRawHeader, and the transaction and uncle hashes of block must be the same as raw
+// block. Full transactions of block are checked by TransactionDetail.VerifyRaw.
+func (b *Block) VerifyRaw(raw *ethtypes.Block) error {
+	if err := verifyRawBody(raw); err != nil {
+		return err
+	}
+
+	if err := b.VerifyRawHeader(raw.Header()); err != nil {
+		return err
+	}
+
+	rawTxs := raw.Transactions()
+	if b.Transactions.vtype == TXLIST_TRANSACTION {
+		details := b.Transactions.Transactions()
+		if len(details) != len(rawTxs) {
+			return errors.Wrapf(ErrDataMismatch, "block has %v transactions but raw block has %v", len(details), len(rawTxs))
+		}
+		for i := range details {
+			if err := details[i].VerifyRaw(rawTxs[i]); err != nil {
+				return errors.WithMessagef(err, "transaction %v", i)
+			}
+		}
+	} else {
+		hashes := b.Transactions.Hashes()
+		if len(hashes) != len(rawTxs) {
+			return errors.Wrapf(ErrDataMismatch, "block has %v transactions but raw block has %v", len(hashes), len(rawTxs))
+		}
+		for i := range hashes {
+			if hashes[i] != rawTxs[i].Hash() {
+				return errors.Wrapf(ErrDataMismatch, "transaction %v hash is %v but raw is %v", i, hashes[i], rawTxs[i].Hash())
+			}
+		}
+	}
+
+	rawUncles := raw.Uncles()
+	if len(b.Uncles) != len(rawUncles) {
+		return errors.Wrapf(ErrDataMismatch, "block has %v uncles but raw block has %v", len(b.Uncles), len(rawUncles))
+	}
+	for i := range b.Uncles {
+		if b.Uncles[i] != rawUncles[i].Hash() {
+			return errors.Wrapf(ErrDataMismatch, "uncle %v hash is %v but raw is %v", i, b.Uncles[i], rawUncles[i].Hash())
+		}
+	}
+
+	if b.Withdrawals != nil {
+		rawWithdrawals := raw.Withdrawals()
+		if len(b.Withdrawals) != len(rawWithdrawals) {
+			return errors.Wrapf(ErrDataMismatch, "block has %v withdrawals but raw block has %v", len(b.Withdrawals), len(rawWithdrawals))
+		}
+		for i := range b.Withdrawals {
+			if b.Withdrawals[i] != *rawWithdrawals[i] {
+				return errors.Wrapf(ErrDataMismatch, "withdrawal %v mismatch", i)
+			}
+		}
+	}
+
+	return nil
+}
+
+// verifyRawBody checks the transactions, uncles and withdrawals of raw block against the roots of its header.
+func verifyRawBody(raw *ethtypes.Block) error {
+	if root := ethtypes.DeriveSha(raw.Transactions(), trie.NewStackTrie(nil)); root != raw.TxHash() {
+		return errors.Wrapf(ErrDataMismatch, "transactions root of raw block should be %v but got %v", root, raw.TxHash())
+	}
+	if hash := ethtypes.CalcUncleHash(raw.Uncles()); hash != raw.UncleHash() {
+		return errors.Wrapf(ErrDataMismatch, "uncles hash of raw block should be %v but got %v", hash, raw.UncleHash())
+	}
+	if expected := raw.Header().WithdrawalsHash; expected != nil {
+		if root := ethtypes.DeriveSha(raw.Withdrawals(), trie.NewStackTrie(nil)); root != *expected {
+			return errors.Wrapf(ErrDataMismatch, "withdrawals root of raw block should be %v but got %v", root, *expected)
+		}
+	}
+	return nil
+}
+
+// VerifyRaw checks the transaction against the raw transaction decoded from its binary encoding, such as the
+// transaction of debug_getRawTransaction. The hash of raw transaction must be the transaction hash, and the fields
+// of transaction must produce the same hash, otherwise the mismatched fields are reported.
+func (t *TransactionDetail) VerifyRaw(raw *ethtypes.Transaction) error {
+	if hash := raw.Hash(); hash != t.Hash {
+		return errors.Wrapf(ErrDataMismatch, "hash of raw transaction is %v but transaction hash is %v", hash, t.Hash)
+	}
+
+	tx, err := t.ToEthTransaction()
+	if err != nil {
+		return errors.Wrap(err, "failed to convert transaction")
+	}
+	if tx.Hash() == t.Hash {
+		return nil
+	}
+	return mismatchedFields("transaction", tx, raw)
+}
+
+// VerifyRaw checks the consensus fields of receipt against the raw receipt decoded from its binary encoding, such
+// as the receipt of debug_getRawReceipts, which contains no derived fields like hashes and gas used.
+func (r *Receipt) VerifyRaw(raw *ethtypes.Receipt) error {
+	var fields []string
+
+	var txType uint64
+	if r.Type != nil {
+		txType = *r.Type
+	}
+	if txType != uint64(raw.Type) {
+		fields = append(fields, "type")
+	}
+
+	if len(raw.PostState) > 0 {
+		if !bytes.Equal(r.Root, raw.PostState) {
+			fields = append(fields, "root")
+		}
+	} else if r.Status == nil || *r.Status != raw.Status {
+		fields = append(fields, "status")
+	}
+
+	if r.CumulativeGasUsed != raw.CumulativeGasUsed {
+		fields = append(fields, "cumulativeGasUsed")
+	}
+	if r.LogsBloom != raw.Bloom {
+		fields = append(fields, "logsBloom")
+	}
+	if !logsEqual(r.Logs, raw.Logs) {
+		fields = append(fields, "logs")
+	}
+
+	if len(fields) > 0 {
+		return errors.Wrapf(ErrDataMismatch, "receipt fields mismatch: %v", strings.Join(fields, ", "))
+	}
+	return nil
+}
+
+// VerifyRawReceipts checks the raw receipts of a block, such as the receipts of debug_getRawReceipts, against the
+// receipts root of the block header, and then checks receipts against them by Receipt.VerifyRaw.
+func VerifyRawReceipts(receiptsRoot common.Hash, receipts []*Receipt, raw []*ethtypes.Receipt) error {
+	if root := ethtypes.DeriveSha(ethtypes.Receipts(raw), trie.NewStackTrie(nil)); root != receiptsRoot {
+		return errors.Wrapf(ErrDataMismatch, "receipts root of raw receipts is %v but expected %v", root, receiptsRoot)
+	}
+
+	if len(receipts) != len(raw) {
+		return errors.Wrapf(ErrDataMismatch, "%v receipts but %v raw receipts", len(receipts), len(raw))
+	}
+	for i := range receipts {
+		if err := receipts[i].VerifyRaw(raw[i]); err != nil {
+			return errors.WithMessagef(err, "receipt %v", i)
+		}
+	}
+	return nil
+}
+
+func logsEqual(logs []*Log, raw []*ethtypes.Log) bool {
+	if len(logs) != len(raw) {
+		return false
+	}
+	for i := range logs {
+		if logs[i].Address != raw[i].Address || !bytes.Equal(logs[i].Data, raw[i].Data) || len(logs[i].Topics) != len(raw[i].Topics) {
+			return false
+		}
+		for j := range logs[i].Topics {
+			if logs[i].Topics[j] != raw[i].Topics[j] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// mismatchedFields returns ErrDataMismatch with the JSON fields that are different between the value converted
+// from JSON and the raw value.
+func mismatchedFields(name string, converted, raw any) error {
+	convertedFields, err := jsonFields(converted)
+	if err != nil {
+		return err
+	}
+	rawFields, err := jsonFields(raw)
+	if err != nil {
+		return err
+	}
+
+	var fields []string
+	for k, v := range convertedFields {
+		if k != "hash" && !bytes.Equal(v, rawFields[k]) {
+			fields = append(fields, k)
+		}
+	}
+	for k := range rawFields {
+		if _, ok := convertedFields[k]; !ok && k != "hash" {
+			fields = append(fields, k)
+		}
+	}
+	sort.Strings(fields)
+
+	return errors.Wrapf(ErrDataMismatch, "%v fields mismatch: %v", name, strings.Join(fields, ", "))
+}
+
+func jsonFields(v any) (map[string]json.RawMessage, error) {
+	j, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(j, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
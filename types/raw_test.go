@@ -0,0 +1,176 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRawTestBlock(t *testing.T) (*ethtypes.Block, []*ethtypes.Receipt) {
+	key, _ := crypto.GenerateKey()
+	signer := ethtypes.LatestSignerForChainID(big.NewInt(1))
+	to := common.HexToAddress("0x1234")
+
+	legacy := ethtypes.MustSignNewTx(key, signer, &ethtypes.LegacyTx{Nonce: 0, To: &to, Gas: 21000, GasPrice: big.NewInt(2e9), Value: big.NewInt(1)})
+	dynamic := ethtypes.MustSignNewTx(key, signer, &ethtypes.DynamicFeeTx{ChainID: big.NewInt(1), Nonce: 1, To: &to, Gas: 50000, GasTipCap: big.NewInt(1e9), GasFeeCap: big.NewInt(3e9), Data: []byte{1, 2}})
+
+	receipts := []*ethtypes.Receipt{
+		{Type: ethtypes.LegacyTxType, Status: ethtypes.ReceiptStatusSuccessful, CumulativeGasUsed: 21000},
+		{Type: ethtypes.DynamicFeeTxType, Status: ethtypes.ReceiptStatusFailed, CumulativeGasUsed: 60000, Logs: []*ethtypes.Log{
+			{Address: to, Topics: []common.Hash{common.HexToHash("0x01")}, Data: []byte{3}},
+		}},
+	}
+	for _, r := range receipts {
+		r.Bloom = ethtypes.CreateBloom(r)
+	}
+
+	header := &ethtypes.Header{
+		ParentHash: common.HexToHash("0xabcd"),
+		Number:     big.NewInt(100),
+		GasLimit:   30_000_000,
+		GasUsed:    60000,
+		Time:       1700000000,
+		Difficulty: new(big.Int),
+		BaseFee:    big.NewInt(params.InitialBaseFee),
+	}
+	block := ethtypes.NewBlock(header, &ethtypes.Body{Transactions: ethtypes.Transactions{legacy, dynamic}}, receipts, trie.NewStackTrie(nil))
+	return block, receipts
+}
+
+// toJsonBlock converts block to the JSON block returned by eth_getBlockByNumber.
+func toJsonBlock(t *testing.T, block *ethtypes.Block, isFull bool) *Block {
+	h := block.Header()
+	b := &Block{
+		BaseFeePerGas:    h.BaseFee,
+		Difficulty:       h.Difficulty,
+		ExtraData:        h.Extra,
+		GasLimit:         h.GasLimit,
+		GasUsed:          h.GasUsed,
+		Hash:             block.Hash(),
+		LogsBloom:        h.Bloom,
+		Miner:            h.Coinbase,
+		MixHash:          &h.MixDigest,
+		Nonce:            &h.Nonce,
+		Number:           h.Number,
+		ParentHash:       h.ParentHash,
+		ReceiptsRoot:     h.ReceiptHash,
+		StateRoot:        h.Root,
+		Timestamp:        h.Time,
+		TransactionsRoot: h.TxHash,
+		Sha3Uncles:       h.UncleHash,
+	}
+
+	if !isFull {
+		var hashes []common.Hash
+		for _, tx := range block.Transactions() {
+			hashes = append(hashes, tx.Hash())
+		}
+		b.Transactions = *NewTxOrHashListByHashes(hashes)
+		return b
+	}
+
+	var details []TransactionDetail
+	for _, tx := range block.Transactions() {
+		j, err := json.Marshal(tx)
+		assert.NoError(t, err)
+		var detail TransactionDetail
+		assert.NoError(t, json.Unmarshal(j, &detail))
+		details = append(details, detail)
+	}
+	b.Transactions = *NewTxOrHashListByTxs(details)
+	return b
+}
+
+func toJsonReceipts(receipts []*ethtypes.Receipt) []*Receipt {
+	result := make([]*Receipt, len(receipts))
+	for i, r := range receipts {
+		var logs []*Log
+		for _, l := range r.Logs {
+			logs = append(logs, &Log{Address: l.Address, Topics: l.Topics, Data: l.Data})
+		}
+		result[i] = &Receipt{
+			CumulativeGasUsed: r.CumulativeGasUsed,
+			Logs:              logs,
+			LogsBloom:         r.Bloom,
+			Status:            Pointer(r.Status),
+			Type:              Pointer(uint64(r.Type)),
+		}
+	}
+	return result
+}
+
+func TestBlockVerifyRaw(t *testing.T) {
+	a := assert.New(t)
+	raw, _ := newRawTestBlock(t)
+
+	a.NoError(toJsonBlock(t, raw, false).VerifyRawHeader(raw.Header()))
+	a.NoError(toJsonBlock(t, raw, false).VerifyRaw(raw))
+	a.NoError(toJsonBlock(t, raw, true).VerifyRaw(raw))
+
+	// header field mismatch with the same hash
+	block := toJsonBlock(t, raw, false)
+	block.GasUsed = 1
+	err := block.VerifyRawHeader(raw.Header())
+	a.True(errors.Is(err, ErrDataMismatch))
+	a.Contains(err.Error(), "gasUsed")
+
+	// block hash mismatch
+	block = toJsonBlock(t, raw, false)
+	block.Hash = common.HexToHash("0x01")
+	a.True(errors.Is(block.VerifyRaw(raw), ErrDataMismatch))
+
+	// transaction field mismatch
+	block = toJsonBlock(t, raw, true)
+	block.Transactions.Transactions()[1].Value = big.NewInt(100)
+	err = block.VerifyRaw(raw)
+	a.True(errors.Is(err, ErrDataMismatch))
+	a.Contains(err.Error(), "transaction 1")
+	a.Contains(err.Error(), "value")
+
+	// transaction hash mismatch
+	block = toJsonBlock(t, raw, false)
+	block.Transactions.Hashes()[0] = common.HexToHash("0x01")
+	a.True(errors.Is(block.VerifyRaw(raw), ErrDataMismatch))
+
+	// raw body mismatch with header
+	tampered := raw.WithBody(ethtypes.Body{Transactions: raw.Transactions()[:1]})
+	err = toJsonBlock(t, raw, false).VerifyRaw(tampered)
+	a.True(errors.Is(err, ErrDataMismatch))
+	a.Contains(err.Error(), "transactions root")
+}
+
+func TestVerifyRawReceipts(t *testing.T) {
+	a := assert.New(t)
+	block, raw := newRawTestBlock(t)
+
+	// receipts decoded from consensus encoding
+	var decoded []*ethtypes.Receipt
+	for _, r := range raw {
+		encoded, err := r.MarshalBinary()
+		a.NoError(err)
+		var receipt ethtypes.Receipt
+		a.NoError(receipt.UnmarshalBinary(encoded))
+		decoded = append(decoded, &receipt)
+	}
+
+	a.NoError(VerifyRawReceipts(block.ReceiptHash(), toJsonReceipts(raw), decoded))
+
+	receipts := toJsonReceipts(raw)
+	receipts[1].Logs[0].Data = []byte{4}
+	receipts[1].Status = Pointer(uint64(1))
+	err := VerifyRawReceipts(block.ReceiptHash(), receipts, decoded)
+	a.True(errors.Is(err, ErrDataMismatch))
+	a.Contains(err.Error(), "receipt 1")
+	a.Contains(err.Error(), "status, logs")
+
+	a.True(errors.Is(VerifyRawReceipts(common.Hash{}, toJsonReceipts(raw), decoded), ErrDataMismatch))
+	a.True(errors.Is(VerifyRawReceipts(block.ReceiptHash(), toJsonReceipts(raw)[:1], decoded), ErrDataMismatch))
+}
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// SimulateOptions is the request of eth_simulateV1, which simulates calls of BlockStateCalls in sequence, each
+// on top of the state of previous calls and blocks.
+type SimulateOptions struct {
+	BlockStateCalls []SimulateBlock `json:"blockStateCalls"`
+	// TraceTransfers adds ETH transfers as ERC-20 Transfer logs from address 0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE.
+	TraceTransfers bool `json:"traceTransfers,omitempty"`
+	// Validation enables the checks of transactions as if they were sent, such as nonce, balance and base fee,
+	// otherwise they are executed like eth_call.
+	Validation bool `json:"validation,omitempty"`
+	// ReturnFullTransactions returns transactions instead of hashes in blocks.
+	ReturnFullTransactions bool `json:"returnFullTransactions,omitempty"`
+}
+
+// SimulateBlock is a simulated block with overrides applied before its calls are executed.
+type SimulateBlock struct {
+	BlockOverrides *BlockOverrides `json:"blockOverrides,omitempty"`
+	StateOverrides *StateOverride  `json:"stateOverrides,omitempty"`
+	Calls          []CallRequest   `json:"calls"`
+}
+
+// SimulatedBlock is a block returned by eth_simulateV1 with results of its calls.
+type SimulatedBlock struct {
+	Block
+	Calls []*SimulatedCallResult `json:"calls"`
+}
+
+// MarshalJSON marshals as JSON.
+func (b SimulatedBlock) MarshalJSON() ([]byte, error) {
+	j, err := json.Marshal(b.Block)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(j, &fields); err != nil {
+		return nil, err
+	}
+	if fields["calls"], err = json.Marshal(b.Calls); err != nil {
+		return nil, err
+	}
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON unmarshals from JSON.
+func (b *SimulatedBlock) UnmarshalJSON(input []byte) error {
+	if err := json.Unmarshal(input, &b.Block); err != nil {
+		return err
+	}
+
+	var dec struct {
+		Calls []*SimulatedCallResult `json:"calls"`
+	}
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	b.Calls = dec.Calls
+	return nil
+}
+
+// SimulatedCallResult is the result of a simulated call.
+//
+//go:generate gencodec -type SimulatedCallResult -field-override simulatedCallResultMarshaling -out gen_simulated_call_result_json.go
+type SimulatedCallResult struct {
+	ReturnData []byte `json:"returnData"`
+	Logs       []*Log `json:"logs"`
+	GasUsed    uint64 `json:"gasUsed"`
+	// Status is 1 if the call succeeded and 0 if it failed.
+	Status uint64             `json:"status"`
+	Error  *SimulateCallError `json:"error,omitempty"`
+}
+
+type simulatedCallResultMarshaling struct {
+	ReturnData hexutil.Bytes  `json:"returnData"`
+	GasUsed    hexutil.Uint64 `json:"gasUsed"`
+	Status     hexutil.Uint64 `json:"status"`
+}
+
+// Succeeded returns whether the call succeeded.
+func (r *SimulatedCallResult) Succeeded() bool {
+	return r.Status == 1
+}
+
+// SimulateCallError is the error of a failed call, such as code 3 with the revert data for reverted calls.
+type SimulateCallError struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Data    string `json:"data,omitempty"`
+}
+
+func (e *SimulateCallError) Error() string {
+	if e.Data != "" {
+		return fmt.Sprintf("%v (code %v, data %v)", e.Message, e.Code, e.Data)
+	}
+	return fmt.Sprintf("%v (code %v)", e.Message, e.Code)
+}
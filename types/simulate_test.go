@@ -0,0 +1,119 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalSimulateOptions(t *testing.T) {
+	a := assert.New(t)
+
+	from := common.HexToAddress("0xc000000000000000000000000000000000000000")
+	to := common.HexToAddress("0xc100000000000000000000000000000000000000")
+	opts := SimulateOptions{
+		BlockStateCalls: []SimulateBlock{{
+			BlockOverrides: &BlockOverrides{Number: (*hexutil.Big)(big.NewInt(10))},
+			StateOverrides: &StateOverride{from: OverrideAccount{Balance: (*hexutil.Big)(big.NewInt(1000))}},
+			Calls:          []CallRequest{{From: &from, To: &to, Value: big.NewInt(1)}},
+		}},
+		TraceTransfers: true,
+		Validation:     true,
+	}
+
+	j, err := json.Marshal(opts)
+	a.NoError(err)
+
+	var decoded map[string]interface{}
+	a.NoError(json.Unmarshal(j, &decoded))
+	a.Equal(true, decoded["traceTransfers"])
+	a.Equal(true, decoded["validation"])
+	a.NotContains(decoded, "returnFullTransactions")
+	block := decoded["blockStateCalls"].([]interface{})[0].(map[string]interface{})
+	a.Equal("0xa", block["blockOverrides"].(map[string]interface{})["Number"])
+	a.Equal("0x3e8", block["stateOverrides"].(map[string]interface{})[strings.ToLower(from.Hex())].(map[string]interface{})["balance"])
+	a.Equal("0x1", block["calls"].([]interface{})[0].(map[string]interface{})["value"])
+}
+
+func TestUnmarshalSimulatedBlock(t *testing.T) {
+	a := assert.New(t)
+
+	j := `[{
+		"baseFeePerGas": "0x0",
+		"difficulty": "0x0",
+		"extraData": "0x",
+		"gasLimit": "0x1c9c380",
+		"gasUsed": "0xa410",
+		"hash": "0x3b1d1fc1d0ed2ed8bcb6b8f2b0e1c0a7a9eafec2b3e5f0c3c2a2c0e3e0e0e0e0",
+		"logsBloom": "0x00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+		"miner": "0x0000000000000000000000000000000000000000",
+		"mixHash": "0x0000000000000000000000000000000000000000000000000000000000000000",
+		"nonce": "0x0000000000000000",
+		"number": "0xa",
+		"parentHash": "0x0000000000000000000000000000000000000000000000000000000000000000",
+		"receiptsRoot": "0x0000000000000000000000000000000000000000000000000000000000000000",
+		"sha3Uncles": "0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347",
+		"size": "0x29b",
+		"stateRoot": "0x0000000000000000000000000000000000000000000000000000000000000000",
+		"timestamp": "0x1",
+		"transactions": ["0xd7a2ecd2b24bca6fdc8e2b9b1e2fb4f8d0a8d4d8a4c6f0f1e9c8d4a2c6e6f4a2"],
+		"transactionsRoot": "0x0000000000000000000000000000000000000000000000000000000000000000",
+		"uncles": [],
+		"withdrawals": [],
+		"withdrawalsRoot": "0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421",
+		"calls": [{
+			"returnData": "0x",
+			"logs": [{
+				"address": "0xeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee",
+				"topics": ["0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"],
+				"data": "0x0000000000000000000000000000000000000000000000000000000000000001",
+				"blockNumber": "0xa",
+				"transactionHash": "0xd7a2ecd2b24bca6fdc8e2b9b1e2fb4f8d0a8d4d8a4c6f0f1e9c8d4a2c6e6f4a2",
+				"transactionIndex": "0x0",
+				"blockHash": "0x3b1d1fc1d0ed2ed8bcb6b8f2b0e1c0a7a9eafec2b3e5f0c3c2a2c0e3e0e0e0e0",
+				"logIndex": "0x0",
+				"removed": false
+			}],
+			"gasUsed": "0x5208",
+			"status": "0x1"
+		}, {
+			"returnData": "0x08c379a0",
+			"logs": [],
+			"gasUsed": "0x5208",
+			"status": "0x0",
+			"error": {"message": "execution reverted", "code": 3, "data": "0x08c379a0"}
+		}]
+	}]`
+
+	var blocks []*SimulatedBlock
+	a.NoError(json.Unmarshal([]byte(j), &blocks))
+	a.Len(blocks, 1)
+	a.Equal(big.NewInt(10), blocks[0].Number)
+	a.Equal(uint64(0xa410), blocks[0].GasUsed)
+	a.Len(blocks[0].Calls, 2)
+
+	success := blocks[0].Calls[0]
+	a.True(success.Succeeded())
+	a.Nil(success.Error)
+	a.Equal(uint64(21000), success.GasUsed)
+	a.Len(success.Logs, 1)
+	a.Equal(common.HexToAddress("0xeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee"), success.Logs[0].Address)
+
+	failed := blocks[0].Calls[1]
+	a.False(failed.Succeeded())
+	a.Equal(3, failed.Error.Code)
+	a.Equal("execution reverted (code 3, data 0x08c379a0)", failed.Error.Error())
+
+	// round trip
+	encoded, err := json.Marshal(blocks)
+	a.NoError(err)
+	var decoded []*SimulatedBlock
+	a.NoError(json.Unmarshal(encoded, &decoded))
+	a.Equal(blocks[0].Hash, decoded[0].Hash)
+	a.Equal(blocks[0].Calls, decoded[0].Calls)
+}
@@ -13,6 +13,32 @@ import (
 
 type TraceOptions []string
 
+// TraceCallRequest is a call of trace_callMany with its trace types.
+type TraceCallRequest struct {
+	Request CallRequest
+	Options TraceOptions
+}
+
+// MarshalJSON marshals as the tuple of call request and trace types.
+func (r TraceCallRequest) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{r.Request, r.Options})
+}
+
+// UnmarshalJSON unmarshals from the tuple of call request and trace types.
+func (r *TraceCallRequest) UnmarshalJSON(input []byte) error {
+	var tuple []json.RawMessage
+	if err := json.Unmarshal(input, &tuple); err != nil {
+		return err
+	}
+	if len(tuple) != 2 {
+		return errors.Errorf("expect tuple of call request and trace types, but got %v elements", len(tuple))
+	}
+	if err := json.Unmarshal(tuple[0], &r.Request); err != nil {
+		return err
+	}
+	return json.Unmarshal(tuple[1], &r.Options)
+}
+
 type TraceType string
 
 const (
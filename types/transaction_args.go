@@ -22,6 +22,19 @@ type ReaderForPopulate interface {
 	BlockByNumber(blockNumber BlockNumber, isFull bool) (val *Block, err error)
 }
 
+// AccessListCreator creates access lists by eth_createAccessList, it is required by Populate if
+// PopulateOption.AccessList is enabled.
+type AccessListCreator interface {
+	CreateAccessList(callRequest CallRequest, blockNum *BlockNumberOrHash) (val *AccessListResult, err error)
+}
+
+// PopulateOption is the option of TransactionArgs.Populate.
+type PopulateOption struct {
+	// AccessList requests an access list for transactions of AccessListTxType and later types without access
+	// list, and keeps it only if it lowers the estimated gas. The reader must implement AccessListCreator.
+	AccessList bool
+}
+
 type TransactionArgs struct {
 	From                 *common.Address `json:"from"`
 	To                   *common.Address `json:"to"`
@@ -135,7 +148,8 @@ func (args *TransactionArgs) ToTransaction() (*types.Transaction, error) {
 	return nil, errors.New("unknown transaction type")
 }
 
-func (args *TransactionArgs) Populate(reader ReaderForPopulate) error {
+// Populate fills the missing fields of args by reader, the option is optional.
+func (args *TransactionArgs) Populate(reader ReaderForPopulate, option ...PopulateOption) error {
 
 	if args.From == nil {
 		return errors.New("from is required")
@@ -166,31 +180,23 @@ func (args *TransactionArgs) Populate(reader ReaderForPopulate) error {
 	if args.To == nil && len(args.data()) == 0 {
 		return errors.New(`contract creation without any data provided`)
 	}
-	// Estimate the gas usage if necessary.
-	if args.Gas == nil {
-		// These fields are immutable during the estimation, safe to
-		// pass the pointer directly.
-		data := args.data()
-		callArgs := CallRequest{
-			From:                 args.From,
-			To:                   args.To,
-			GasPrice:             (*big.Int)(args.GasPrice),
-			MaxFeePerGas:         (*big.Int)(args.MaxFeePerGas),
-			MaxPriorityFeePerGas: (*big.Int)(args.MaxPriorityFeePerGas),
-			Value:                (*big.Int)(args.Value),
-			Data:                 data,
-			AccessList:           args.AccessList,
-			AuthorizationList:    args.AuthorizationList,
-		}
 
-		latest := BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
-		estimated, err := reader.EstimateGas(callArgs, &latest, nil, nil)
+	withAccessList := len(option) > 0 && option[0].AccessList && args.AccessList == nil && *args.TxType != types.LegacyTxType
+
+	// Estimate the gas usage if necessary, the estimation is also compared with the access list.
+	if args.Gas == nil || withAccessList {
+		estimated, err := args.estimateGas(reader)
 		if err != nil {
-			return errors.Wrap(err, "failed to estimate")
+			return err
+		}
+		if withAccessList {
+			if estimated, err = args.populateAccessList(reader, estimated); err != nil {
+				return errors.Wrap(err, "failed to populate access list")
+			}
+		}
+		if args.Gas == nil {
+			args.Gas = (*hexutil.Uint64)(&estimated)
 		}
-
-		temp := estimated.Uint64()
-		args.Gas = (*hexutil.Uint64)(&temp)
 	}
 	if args.ChainID == nil {
 		id, err := reader.ChainId()
@@ -203,6 +209,62 @@ func (args *TransactionArgs) Populate(reader ReaderForPopulate) error {
 	return nil
 }
 
+// populateAccessList sets the access list created by node if it lowers the estimated gas without access list, and
+// returns the lower estimation.
+func (args *TransactionArgs) populateAccessList(reader ReaderForPopulate, estimated uint64) (uint64, error) {
+	creator, ok := reader.(AccessListCreator)
+	if !ok {
+		return 0, errors.New("reader does not support creating access list")
+	}
+
+	latest := BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+	result, err := creator.CreateAccessList(args.callRequest(), &latest)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to create access list")
+	}
+	// the failure of call is reported by gas estimation
+	if result.Error != "" || len(result.AccessList) == 0 {
+		return estimated, nil
+	}
+
+	args.AccessList = &result.AccessList
+	with, err := args.estimateGas(reader)
+	if err != nil {
+		args.AccessList = nil
+		return 0, err
+	}
+	if with >= estimated {
+		args.AccessList = nil
+		return estimated, nil
+	}
+	return with, nil
+}
+
+func (args *TransactionArgs) estimateGas(reader ReaderForPopulate) (uint64, error) {
+	latest := BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+	estimated, err := reader.EstimateGas(args.callRequest(), &latest, nil, nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to estimate")
+	}
+	return estimated.Uint64(), nil
+}
+
+func (args *TransactionArgs) callRequest() CallRequest {
+	// These fields are immutable during the estimation, safe to
+	// pass the pointer directly.
+	return CallRequest{
+		From:                 args.From,
+		To:                   args.To,
+		GasPrice:             (*big.Int)(args.GasPrice),
+		MaxFeePerGas:         (*big.Int)(args.MaxFeePerGas),
+		MaxPriorityFeePerGas: (*big.Int)(args.MaxPriorityFeePerGas),
+		Value:                (*big.Int)(args.Value),
+		Data:                 args.data(),
+		AccessList:           args.AccessList,
+		AuthorizationList:    args.AuthorizationList,
+	}
+}
+
 func (args *TransactionArgs) populateTxtypeAndGasPrice(reader ReaderForPopulate) error {
 	if args.GasPrice != nil && (args.MaxFeePerGas != nil || args.MaxPriorityFeePerGas != nil) {
 		return errors.New("both gasPrice and (maxFeePerGas or maxPriorityFeePerGas) specified")
@@ -288,8 +350,8 @@ func (args *TransactionArgs) populateTxtypeAndGasPrice(reader ReaderForPopulate)
 
 // ToTransaction converts the arguments to a transaction.
 // This assumes that Populate has been called.
-func (args *TransactionArgs) PopulateAndToTransaction(reader ReaderForPopulate) (*types.Transaction, error) {
-	if err := args.Populate(reader); err != nil {
+func (args *TransactionArgs) PopulateAndToTransaction(reader ReaderForPopulate, option ...PopulateOption) (*types.Transaction, error) {
+	if err := args.Populate(reader, option...); err != nil {
 		return nil, err
 	}
 	return args.ToTransaction()
@@ -257,6 +257,88 @@ func TestPopulate(t *testing.T) {
 	}
 }
 
+type mockAccessListReader struct {
+	mockPopulateReader
+	accessList ethrpctypes.AccessList
+	// gas used reported by eth_createAccessList
+	gasUsed int64
+	// gas estimated with the access list
+	gasWithList int64
+	estimated   int
+}
+
+func (m *mockAccessListReader) CreateAccessList(callRequest CallRequest, blockNum *BlockNumberOrHash) (val *AccessListResult, err error) {
+	return &AccessListResult{AccessList: m.accessList, GasUsed: uint64(m.gasUsed)}, nil
+}
+
+func (m *mockAccessListReader) EstimateGas(callRequest CallRequest, blockNum *BlockNumberOrHash, overrides *StateOverride, blockOverrides *BlockOverrides) (val *big.Int, err error) {
+	m.estimated++
+	if callRequest.AccessList != nil {
+		return big.NewInt(m.gasWithList), nil
+	}
+	return big.NewInt(0x26), nil //38
+}
+
+func TestPopulateAccessList(t *testing.T) {
+	ast := assert.New(t)
+
+	accessList := ethrpctypes.AccessList{{Address: common.HexToAddress("0x01"), StorageKeys: []common.Hash{{}}}}
+	option := PopulateOption{AccessList: true}
+
+	// keep access list which lowers the estimated gas, and use the estimation with access list
+	args := &TransactionArgs{From: &common.Address{}, To: &common.Address{}}
+	reader := &mockAccessListReader{accessList: accessList, gasUsed: 0x18, gasWithList: 0x20}
+	ast.NoError(args.Populate(reader, option))
+	ast.Equal(&accessList, args.AccessList)
+	ast.Equal(uint64(0x20), uint64(*args.Gas))
+	ast.Equal(uint8(ethrpctypes.DynamicFeeTxType), *args.TxType)
+	ast.Equal(2, reader.estimated)
+
+	// drop access list which doesn't lower the estimated gas, even if its gas used is lower
+	for _, gasWithList := range []int64{0x26, 0x30} {
+		args = &TransactionArgs{From: &common.Address{}, To: &common.Address{}}
+		reader = &mockAccessListReader{accessList: accessList, gasUsed: 0x20, gasWithList: gasWithList}
+		ast.NoError(args.Populate(reader, option))
+		ast.Nil(args.AccessList)
+		ast.Equal(uint64(0x26), uint64(*args.Gas))
+		ast.Equal(2, reader.estimated)
+	}
+
+	// keep gas specified
+	args = &TransactionArgs{From: &common.Address{}, To: &common.Address{}, Gas: (*hexutil.Uint64)(Pointer(uint64(0x40)))}
+	ast.NoError(args.Populate(&mockAccessListReader{accessList: accessList, gasWithList: 0x20}, option))
+	ast.Equal(&accessList, args.AccessList)
+	ast.Equal(uint64(0x40), uint64(*args.Gas))
+
+	// legacy transaction has no access list
+	args = &TransactionArgs{From: &common.Address{}, To: &common.Address{}, GasPrice: (*hexutil.Big)(big.NewInt(33))}
+	ast.NoError(args.Populate(&mockAccessListReader{accessList: accessList, gasWithList: 0x20}, option))
+	ast.Nil(args.AccessList)
+
+	// not enabled by default
+	args = &TransactionArgs{From: &common.Address{}, To: &common.Address{}}
+	ast.NoError(args.Populate(&mockAccessListReader{accessList: accessList, gasWithList: 0x20}))
+	ast.Nil(args.AccessList)
+
+	// reader must create access list
+	args = &TransactionArgs{From: &common.Address{}, To: &common.Address{}}
+	ast.Error(args.Populate(&mockPopulateReader{}, option))
+}
+
+func TestAccessListResultJSON(t *testing.T) {
+	ast := assert.New(t)
+
+	input := `{"accessList":[{"address":"0x0000000000000000000000000000000000000001","storageKeys":["0x0000000000000000000000000000000000000000000000000000000000000002"]}],"gasUsed":"0x5208"}`
+	var result AccessListResult
+	ast.NoError(json.Unmarshal([]byte(input), &result))
+	ast.Equal(uint64(21000), result.GasUsed)
+	ast.Equal(common.HexToAddress("0x01"), result.AccessList[0].Address)
+
+	output, err := json.Marshal(result)
+	ast.NoError(err)
+	ast.JSONEq(input, string(output))
+}
+
 func TestJsonMarshalHexBytes(t *testing.T) {
 	j, _ := json.Marshal((*hexutil.Bytes)(nil))
 	fmt.Printf("%s\n", string(j))
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/pkg/errors"
+)
+
+// TypedData is the EIP-712 typed structured data in the standard JSON format
+// with domain, types, primaryType and message.
+type TypedData = apitypes.TypedData
+type TypedDataDomain = apitypes.TypedDataDomain
+type TypedDataMessage = apitypes.TypedDataMessage
+type TypedDataTypes = apitypes.Types
+type TypedDataType = apitypes.Type
+
+// TypedDataHash returns the EIP-712 digest of typed data, which is
+// keccak256("\x19\x01" ‖ domainSeparator ‖ hashStruct(message)).
+func TypedDataHash(typedData TypedData) (common.Hash, error) {
+	if _, ok := typedData.Types["EIP712Domain"]; !ok {
+		return common.Hash{}, errors.New("type EIP712Domain is not defined")
+	}
+	if _, ok := typedData.Types[typedData.PrimaryType]; !ok {
+		return common.Hash{}, errors.Errorf("primary type %v is not defined", typedData.PrimaryType)
+	}
+
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return common.Hash{}, errors.Wrap(err, "failed to hash typed data")
+	}
+	return common.BytesToHash(hash), nil
+}
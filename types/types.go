@@ -47,16 +47,14 @@ type Block struct {
 	BlobGasUsed           uint64                `json:"blobGasUsed,omitempty"`
 	ExcessBlobGas         uint64                `json:"excessBlobGas,omitempty"`
 	ParentBeaconBlockRoot *common.Hash          `json:"parentBeaconBlockRoot,omitempty"`
+	RequestsHash          *common.Hash          `json:"requestsHash,omitempty"`
 }
 
+// Header returns the header of block.
+// MixHash and Nonce are treated as zero if absent, they are not returned by some nodes such as parity and conflux espace.
 func (b *Block) Header() (*Header, error) {
-
-	if b.MixHash == nil {
-		return nil, errors.New("MixHash is nil")
-	}
-
-	if b.Nonce == nil {
-		return nil, errors.New("Nonce is nil")
+	if b.Number == nil {
+		return nil, errors.New("Number is nil")
 	}
 
 	h := Header{
@@ -66,27 +64,81 @@ func (b *Block) Header() (*Header, error) {
 		},
 
 		Header: ethtypes.Header{
-			ParentHash:  b.ParentHash,
-			UncleHash:   b.Sha3Uncles,
-			Coinbase:    b.Miner,
-			Root:        b.StateRoot,
-			TxHash:      b.TransactionsRoot,
-			ReceiptHash: b.ReceiptsRoot,
-			Bloom:       b.LogsBloom,
-			Difficulty:  b.Difficulty,
-			Number:      b.Number,
-			GasLimit:    b.GasLimit,
-			GasUsed:     b.GasUsed,
-			Time:        b.Timestamp,
-			Extra:       b.ExtraData,
-			MixDigest:   *b.MixHash,
-			Nonce:       *b.Nonce,
-			BaseFee:     b.BaseFeePerGas,
+			ParentHash:       b.ParentHash,
+			UncleHash:        b.Sha3Uncles,
+			Coinbase:         b.Miner,
+			Root:             b.StateRoot,
+			TxHash:           b.TransactionsRoot,
+			ReceiptHash:      b.ReceiptsRoot,
+			Bloom:            b.LogsBloom,
+			Difficulty:       b.Difficulty,
+			Number:           b.Number,
+			GasLimit:         b.GasLimit,
+			GasUsed:          b.GasUsed,
+			Time:             b.Timestamp,
+			Extra:            b.ExtraData,
+			BaseFee:          b.BaseFeePerGas,
+			WithdrawalsHash:  b.WithdrawalsRoot,
+			ParentBeaconRoot: b.ParentBeaconBlockRoot,
+			RequestsHash:     b.RequestsHash,
 		},
 	}
+
+	if h.Header.Difficulty == nil {
+		h.Header.Difficulty = new(big.Int)
+	}
+
+	if b.MixHash != nil {
+		h.Header.MixDigest = *b.MixHash
+	}
+
+	if b.Nonce != nil {
+		h.Header.Nonce = *b.Nonce
+	}
+
+	// blob gas fields are introduced together with parent beacon block root by cancun
+	if b.ParentBeaconBlockRoot != nil {
+		blobGasUsed, excessBlobGas := b.BlobGasUsed, b.ExcessBlobGas
+		h.Header.BlobGasUsed = &blobGasUsed
+		h.Header.ExcessBlobGas = &excessBlobGas
+	}
 	return &h, nil
 }
 
+// ToEthBlock converts block to go-ethereum block, the block must contain full transactions.
+// Note uncles are not included because only uncle hashes are available in the block.
+func (b *Block) ToEthBlock() (*ethtypes.Block, error) {
+	h, err := b.Header()
+	if err != nil {
+		return nil, err
+	}
+
+	if b.Transactions.vtype == TXLIST_HASH && len(b.Transactions.Hashes()) > 0 {
+		return nil, errors.New("block does not contain full transactions")
+	}
+
+	details := b.Transactions.Transactions()
+	txs := make([]*ethtypes.Transaction, len(details))
+	for i := range details {
+		if txs[i], err = details[i].ToEthTransaction(); err != nil {
+			return nil, errors.Wrapf(err, "failed to convert transaction %v", i)
+		}
+	}
+
+	var withdrawals []*ethtypes.Withdrawal
+	if b.Withdrawals != nil {
+		withdrawals = make([]*ethtypes.Withdrawal, len(b.Withdrawals))
+		for i := range b.Withdrawals {
+			withdrawals[i] = &b.Withdrawals[i]
+		}
+	}
+
+	return ethtypes.NewBlockWithHeader(&h.Header).WithBody(ethtypes.Body{
+		Transactions: txs,
+		Withdrawals:  withdrawals,
+	}), nil
+}
+
 type blockMarshaling struct {
 	Author          *common.Address      `json:"author,omitempty"`
 	BaseFeePerGas   *hexutil.Big         `json:"baseFeePerGas,omitempty"`
@@ -120,6 +172,7 @@ type blockMarshaling struct {
 	BlobGasUsed           hexutil.Uint64        `json:"blobGasUsed,omitempty"`
 	ExcessBlobGas         hexutil.Uint64        `json:"excessBlobGas,omitempty"`
 	ParentBeaconBlockRoot *common.Hash          `json:"parentBeaconBlockRoot,omitempty"`
+	RequestsHash          *common.Hash          `json:"requestsHash,omitempty"`
 }
 
 // "testomit" tag is used to omit the field in rpc test, omit when testomit is true and un-omit when testomit is false.
@@ -158,6 +211,20 @@ type TransactionDetail struct {
 	YParity          *uint64         `json:"yParity,omitempty"`
 }
 
+// ToEthTransaction converts the transaction detail to go-ethereum signed transaction.
+func (t *TransactionDetail) ToEthTransaction() (*ethtypes.Transaction, error) {
+	j, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx ethtypes.Transaction
+	if err := json.Unmarshal(j, &tx); err != nil {
+		return nil, err
+	}
+	return &tx, nil
+}
+
 type plainTransactionMarshaling struct {
 	Accesses             ethtypes.AccessList             `json:"accessList,omitempty"`
 	AuthorizationList    []ethtypes.SetCodeAuthorization `json:"authorizationList,omitempty"`
@@ -8,6 +8,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/holiman/uint256"
 
 	"github.com/openweb3/go-rpc-provider"
@@ -347,3 +348,51 @@ func TestUnmarshalFeeHistory(t *testing.T) {
 	j, _ := json.Marshal(feeHistory)
 	assert.Equal(t, expect, string(j))
 }
+
+func TestBlockHeaderWithoutSealFields(t *testing.T) {
+	a := assert.New(t)
+	// block returned by parity style nodes without mixHash and nonce
+	j := `{"difficulty":"0x0","extraData":"0x","gasLimit":"0x1c9c380","gasUsed":"0x0","hash":"0xce263bf1c206021cdf61bc983c6960ecc036db036a8eceea003563322da14177","logsBloom":"0x00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000","miner":"0x166d0ff7691030b0ca33d4e60e842cd300a3010d","number":"0x6a95094","parentHash":"0x286a356b438dfa21243799cd31cbd0d9a4f66c1c701ff36aa057ce338b444290","receiptsRoot":"0x09f8709ea9f344a810811a373b30861568f5686e649d6177fd92ea2db7477508","size":"0x0","stateRoot":"0xcc8d03c2f1f3ff92de2782a87ba538e60bfa185ec83b2b4be743adb7c5f8f73c","timestamp":"0x63f2e8c7","transactions":[],"transactionsRoot":"0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421","uncles":[],"sha3Uncles":"0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347"}`
+
+	var b Block
+	b.Transactions = *NewTxOrHashList(true)
+	a.NoError(json.Unmarshal([]byte(j), &b))
+
+	h, err := b.Header()
+	a.NoError(err)
+	a.Equal(common.Hash{}, h.MixDigest)
+	a.Equal(ethtypes.BlockNonce{}, h.Nonce)
+	a.Equal(b.Number, h.Number)
+	a.Nil(h.BlobGasUsed)
+
+	eb, err := b.ToEthBlock()
+	a.NoError(err)
+	a.Equal(b.Number.Uint64(), eb.NumberU64())
+	a.Len(eb.Transactions(), 0)
+}
+
+func TestTransactionDetailToEthTransaction(t *testing.T) {
+	a := assert.New(t)
+	key, _ := crypto.HexToECDSA("9ec393923a14eeb557600010ea05d635c667a6995418f8a8f4bdecc63dfe0bb9")
+	to := common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+	tx, err := ethtypes.SignNewTx(key, ethtypes.LatestSignerForChainID(big.NewInt(1)), &ethtypes.DynamicFeeTx{
+		ChainID:   big.NewInt(1),
+		Nonce:     3,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(2),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(100),
+	})
+	a.NoError(err)
+
+	j, err := json.Marshal(tx)
+	a.NoError(err)
+
+	var detail TransactionDetail
+	a.NoError(json.Unmarshal(j, &detail))
+
+	converted, err := detail.ToEthTransaction()
+	a.NoError(err)
+	a.Equal(tx.Hash(), converted.Hash())
+}